@@ -18,6 +18,8 @@ func (b Body) Chain() ValidationChain {
 //
 // Parameters:
 //   - field: the name of the field to validate. It uses [gjson] for its json field extraction syntax.
+//     A "*" segment matches every element of an array or property of an object and "**" matches at any depth,
+//     in which case the chain runs once for every concrete path (e.g. "items[3].sku").
 //   - errFmtFunc: a handler for formatting error messages.
 //
 // [gjson]: https://github.com/tidwall/gjson?tab=readme-ov-file#path-syntax
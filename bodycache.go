@@ -0,0 +1,129 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+)
+
+// ginValidatorCtxBodyStoreName is the key, where the parsed request body is cached for the lifetime of a request.
+const ginValidatorCtxBodyStoreName string = "__ginvalidator__ctx__body__"
+
+// requestBody is the request body, read and parsed once per request and shared by every chain,
+// [OneOf] and [CheckSchema] that runs on the same [gin.Context].
+type requestBody struct {
+	contentType    string       // the Content-Type header the body was sent with
+	isForm         bool         // whether the body was decoded into form values rather than a JSON document
+	implicitArrays bool         // whether indexes and wildcards treat a single value as a one-element array
+	json           gjson.Result // the parsed JSON document, for bodies decoded into JSON
+	form           url.Values   // the parsed form values, for url-encoded and multipart bodies
+	err            error        // the error that occurred while reading or decoding the body, if any
+}
+
+// getRequestBody returns the parsed body of the request, reading and parsing it on first use.
+//
+// The body is always restored on the request, so downstream handlers can read it again,
+// except for a multipart body, which is parsed from the request into its MultipartForm instead.
+// Decoding errors are cached alongside the body, so a malformed body is only parsed once as well.
+func getRequestBody(ctx *gin.Context) (*requestBody, error) {
+	if ctx == nil {
+		return nil, ErrFieldExtractionFromNilCtx
+	}
+
+	if data, ok := ctx.Get(ginValidatorCtxBodyStoreName); ok {
+		if body, ok := data.(*requestBody); ok {
+			return body, body.err
+		}
+	}
+
+	body := parseRequestBody(ctx)
+	ctx.Set(ginValidatorCtxBodyStoreName, body)
+
+	return body, body.err
+}
+
+// parseRequestBody reads the request body and decodes it with the decoder registered for its Content-Type.
+// An empty body is treated as an empty document, whatever its Content-Type.
+func parseRequestBody(ctx *gin.Context) *requestBody {
+	body := &requestBody{contentType: ctx.GetHeader("Content-Type")}
+
+	mediaType, params, contentTypeErr := parseContentType(body.contentType)
+	decoder, ok := lookupBodyDecoder(mediaType)
+
+	// A multipart body is not buffered, so that its files are streamed to disk rather than read into memory.
+	if contentTypeErr == nil && ok && decoder.multipart {
+		body.isForm = true
+		body.form, body.err = parseMultipartBody(ctx)
+		return body
+	}
+
+	data, err := ctx.GetRawData()
+	if err != nil {
+		body.err = err
+		return body
+	}
+
+	ctx.Request.Body = io.NopCloser(bytes.NewBuffer(data))
+
+	if len(data) == 0 {
+		return body
+	}
+
+	if contentTypeErr != nil || !ok {
+		body.err = fmt.Errorf("%s is %w", body.contentType, ErrExtractionInvalidContentType)
+		return body
+	}
+
+	if decoder.decodeForm != nil {
+		body.isForm = true
+		body.form, body.err = decoder.decodeForm(data, params)
+		return body
+	}
+
+	document, err := decoder.decodeJSON(data, params)
+	if err != nil {
+		body.err = fmt.Errorf("%w: %w", ErrExtractionInvalidBody, err)
+		return body
+	}
+
+	body.json = gjson.ParseBytes(document)
+	body.implicitArrays = decoder.implicitArrays
+
+	return body
+}
+
+// parseMultipartBody parses a multipart body once per request with gin's MultipartForm, which keeps at most
+// the engine's MaxMultipartMemory bytes of files in memory, and returns its non-file values.
+//
+// The form is kept as the request's MultipartForm, so gin's FormFile and PostForm see the same values and files,
+// and the server removes the temporary files after the request.
+func parseMultipartBody(ctx *gin.Context) (url.Values, error) {
+	if _, err := ctx.MultipartForm(); err != nil {
+		// An empty body has no first part.
+		if errors.Is(err, io.EOF) && ctx.Request.MultipartForm == nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: %w", ErrExtractionInvalidBody, err)
+	}
+
+	return url.Values(ctx.Request.MultipartForm.Value), nil
+}
+
+// getRequestFiles returns the files uploaded with a multipart body, by field. Other bodies have no files.
+func getRequestFiles(ctx *gin.Context) (map[string][]*multipart.FileHeader, error) {
+	if _, err := getRequestBody(ctx); err != nil {
+		return nil, err
+	}
+
+	if ctx.Request.MultipartForm == nil {
+		return nil, nil
+	}
+
+	return ctx.Request.MultipartForm.File, nil
+}
@@ -0,0 +1,183 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+)
+
+// countingReadCloser counts how many times the request body was read until EOF.
+type countingReadCloser struct {
+	reader io.Reader
+	reads  *int
+}
+
+func (c countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.reader.Read(p)
+	if err == io.EOF {
+		*c.reads++
+	}
+	return n, err
+}
+
+func (c countingReadCloser) Close() error { return nil }
+
+func TestGetRequestBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		field       string
+		want        string
+		streamed    bool // whether the body is parsed from the request rather than read and restored
+	}{
+		{name: "JSON body is parsed once.", body: `{"name":"John","age":30}`, contentType: "application/json", field: "age", want: "30"},
+		{name: "Url-encoded body is parsed once.", body: `name=John&age=30`, contentType: "application/x-www-form-urlencoded", field: "age", want: "30"},
+		{
+			name:        "Multipart body is parsed once.",
+			body:        "--xyz\r\nContent-Disposition: form-data; name=\"age\"\r\n\r\n30\r\n--xyz--\r\n",
+			contentType: "multipart/form-data; boundary=xyz",
+			field:       "age",
+			want:        "30",
+			streamed:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := createTestGinCtx(ginCtxReqOpts{contentType: test.contentType})
+			reads := 0
+			ctx.Request.Body = countingReadCloser{reader: strings.NewReader(test.body), reads: &reads}
+
+			firstReads := 0
+			for i := 0; i < 5; i++ {
+				value, _, err := extractFieldValFromBody(ctx, test.field)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if value != test.want {
+					t.Errorf("got %q, want %q", value, test.want)
+				}
+
+				if i == 0 {
+					firstReads = reads
+				}
+			}
+
+			if reads != firstReads {
+				t.Errorf("body read %d times, want %d: it was parsed again", reads, firstReads)
+			}
+
+			if test.streamed {
+				if ctx.Request.MultipartForm == nil {
+					t.Error("got no MultipartForm on the request, want the parsed form")
+				}
+				return
+			}
+
+			if reads != 1 {
+				t.Errorf("body read %d times, want 1", reads)
+			}
+
+			restored, _ := io.ReadAll(ctx.Request.Body)
+			if string(restored) != test.body {
+				t.Errorf("got restored body %q, want %q", restored, test.body)
+			}
+		})
+	}
+}
+
+func TestRequestBodySharedAcrossChains(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	var downstreamBody string
+	router.POST("/test",
+		NewBodyChain("a", nil).Alpha(nil).Validate(),
+		OneOf([]ValidationChain{NewBodyChain("b", nil).Numeric(nil)}),
+		CheckSchema(Schema{"c": {In: BodyLocation}}),
+		func(ctx *gin.Context) {
+			data, _ := ctx.GetRawData()
+			downstreamBody = string(data)
+		},
+	)
+
+	body := `{"a":"x","b":"1","c":"z"}`
+	reads := 0
+	req, _ := http.NewRequest(http.MethodPost, "/test", nil)
+	req.Body = countingReadCloser{reader: strings.NewReader(body), reads: &reads}
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	if reads != 1 {
+		t.Errorf("body read %d times, want 1", reads)
+	}
+
+	if downstreamBody != body {
+		t.Errorf("got downstream body %q, want %q", downstreamBody, body)
+	}
+}
+
+// benchmarkBodyChains runs numOfChains body chains against a single JSON request.
+// When uncached is true every chain is preceded by a handler reading ctx.Request.Body again,
+// putting it back and looking the field up, which adds the work each chain did before the body was cached.
+func benchmarkBodyChains(b *testing.B, numOfChains int, uncached bool) {
+	gin.SetMode(gin.TestMode)
+
+	var payload strings.Builder
+	payload.WriteString("{")
+	handlers := make([]gin.HandlerFunc, 0, numOfChains*2+1)
+
+	for i := 0; i < numOfChains; i++ {
+		if i > 0 {
+			payload.WriteString(",")
+		}
+		fmt.Fprintf(&payload, `"field%d":"value%d"`, i, i)
+
+		field := fmt.Sprintf("field%d", i)
+		if uncached {
+			handlers = append(handlers, func(ctx *gin.Context) {
+				data, _ := ctx.GetRawData()
+				ctx.Request.Body = io.NopCloser(bytes.NewReader(data))
+				_ = gjson.GetBytes(data, field).String()
+			})
+		}
+		handlers = append(handlers, NewBodyChain(field, nil).Alphanumeric(nil).Validate())
+	}
+	payload.WriteString("}")
+	handlers = append(handlers, func(ctx *gin.Context) {})
+
+	router := gin.New()
+	router.POST("/test", handlers...)
+	body := []byte(payload.String())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+}
+
+func BenchmarkBodyChains(b *testing.B) {
+	for _, numOfChains := range []int{1, 5, 20} {
+		b.Run(fmt.Sprintf("chains=%d/uncached", numOfChains), func(b *testing.B) {
+			benchmarkBodyChains(b, numOfChains, true)
+		})
+		b.Run(fmt.Sprintf("chains=%d/cached", numOfChains), func(b *testing.B) {
+			benchmarkBodyChains(b, numOfChains, false)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package ginvalidator
+
+import (
+	"mime"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/tidwall/gjson"
+)
+
+// BodyDecoderFunc decodes a raw request body into a JSON document.
+// Body fields are then resolved against the returned document using [gjson] path syntax,
+// so every validator, sanitizer and wildcard path works the same regardless of the original format.
+//
+// Parameters:
+//   - raw: the raw request body.
+//   - params: the parameters of the Content-Type media type (e.g. "charset").
+//
+// [gjson]: https://github.com/tidwall/gjson?tab=readme-ov-file#path-syntax
+type BodyDecoderFunc func(raw []byte, params map[string]string) ([]byte, error)
+
+// formDecoderFunc decodes a raw request body into form values, which body fields are resolved against by name.
+type formDecoderFunc func(raw []byte, params map[string]string) (url.Values, error)
+
+// bodyDecoder is an entry of the body decoder registry. Exactly one of its decode functions is set,
+// except for multipart bodies, which are parsed from the request rather than decoded from its raw bytes.
+type bodyDecoder struct {
+	decodeJSON     BodyDecoderFunc // decodes the body into a JSON document
+	decodeForm     formDecoderFunc // decodes the body into form values
+	multipart      bool            // whether the body is a multipart form, parsed by parseMultipartBody
+	implicitArrays bool            // whether indexes and wildcards treat a single value as a one-element array
+}
+
+var (
+	bodyDecodersMu sync.RWMutex
+	bodyDecoders   = map[string]bodyDecoder{
+		"application/json":                  {decodeJSON: decodeJSONBody},
+		"application/*+json":                {decodeJSON: decodeJSONBody},
+		"application/x-www-form-urlencoded": {decodeForm: decodeURLEncodedBody},
+		"multipart/form-data":               {multipart: true},
+		"application/xml":                   {decodeJSON: decodeXMLBody, implicitArrays: true},
+		"application/*+xml":                 {decodeJSON: decodeXMLBody, implicitArrays: true},
+		"text/xml":                          {decodeJSON: decodeXMLBody, implicitArrays: true},
+	}
+)
+
+// RegisterBodyDecoder registers the decoder used for request bodies of the given media type,
+// replacing any decoder previously registered for it. Media type parameters are ignored when matching.
+//
+// The media type can be:
+//   - an exact media type, e.g. "application/msgpack".
+//   - a structured syntax suffix, e.g. "application/*+yaml" or "*/*+yaml".
+//   - a subtype wildcard, e.g. "text/*".
+//   - the catch-all "*/*".
+//
+// When several patterns match a request, the most specific one wins, in the order listed above.
+// JSON ("application/json" and "application/*+json"), XML ("application/xml", "application/*+xml" and "text/xml"),
+// "application/x-www-form-urlencoded" and "multipart/form-data" are registered by default.
+//
+// Example:
+//
+//	ginvalidator.RegisterBodyDecoder("application/yaml", func(raw []byte, params map[string]string) ([]byte, error) {
+//	  var doc any
+//	  if err := yaml.Unmarshal(raw, &doc); err != nil {
+//	    return nil, err
+//	  }
+//	  return json.Marshal(doc)
+//	})
+func RegisterBodyDecoder(mediaType string, decoder BodyDecoderFunc) {
+	bodyDecodersMu.Lock()
+	defer bodyDecodersMu.Unlock()
+
+	bodyDecoders[strings.ToLower(mediaType)] = bodyDecoder{decodeJSON: decoder}
+}
+
+// lookupBodyDecoder finds the most specific decoder registered for a parsed media type.
+func lookupBodyDecoder(mediaType string) (bodyDecoder, bool) {
+	bodyDecodersMu.RLock()
+	defer bodyDecodersMu.RUnlock()
+
+	for _, candidate := range mediaTypeCandidates(mediaType) {
+		if decoder, ok := bodyDecoders[candidate]; ok {
+			return decoder, true
+		}
+	}
+
+	return bodyDecoder{}, false
+}
+
+// mediaTypeCandidates lists the registry keys that can match a media type, from most to least specific.
+func mediaTypeCandidates(mediaType string) []string {
+	candidates := []string{mediaType}
+
+	typ, subtype, ok := strings.Cut(mediaType, "/")
+	if !ok {
+		return candidates
+	}
+
+	if i := strings.LastIndex(subtype, "+"); i >= 0 {
+		suffix := subtype[i:]
+		candidates = append(candidates, typ+"/*"+suffix, "*/*"+suffix)
+	}
+
+	return append(candidates, typ+"/*", "*/*")
+}
+
+// parseContentType parses a Content-Type header into its lower-cased media type and parameters.
+func parseContentType(contentType string) (string, map[string]string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return strings.ToLower(mediaType), params, nil
+}
+
+// decodeJSONBody checks that the body is valid JSON and returns it unchanged.
+func decodeJSONBody(raw []byte, params map[string]string) ([]byte, error) {
+	if !gjson.ValidBytes(raw) {
+		return nil, ErrExtractionInvalidJSON
+	}
+
+	return raw, nil
+}
+
+// decodeURLEncodedBody parses an "application/x-www-form-urlencoded" body.
+func decodeURLEncodedBody(raw []byte, params map[string]string) (url.Values, error) {
+	// Like gin's PostForm, keep whatever pairs could be parsed from a partially malformed body.
+	values, _ := url.ParseQuery(string(raw))
+	return values, nil
+}
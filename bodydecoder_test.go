@@ -0,0 +1,116 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestMediaTypeCandidates(t *testing.T) {
+	tests := []struct {
+		mediaType string
+		want      []string
+	}{
+		{mediaType: "application/json", want: []string{"application/json", "application/*", "*/*"}},
+		{mediaType: "application/vnd.api+json", want: []string{"application/vnd.api+json", "application/*+json", "*/*+json", "application/*", "*/*"}},
+		{mediaType: "text/plain", want: []string{"text/plain", "text/*", "*/*"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mediaType, func(t *testing.T) {
+			got := mediaTypeCandidates(test.mediaType)
+
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBodyContentTypeNegotiation(t *testing.T) {
+	RegisterBodyDecoder("text/*", func(raw []byte, params map[string]string) ([]byte, error) {
+		doc := make(map[string]string)
+		for _, line := range strings.Split(string(raw), "\n") {
+			if key, value, ok := strings.Cut(line, ":"); ok {
+				doc[key] = strings.TrimSpace(value)
+			}
+		}
+		return json.Marshal(doc)
+	})
+	t.Cleanup(func() {
+		bodyDecodersMu.Lock()
+		delete(bodyDecoders, "text/*")
+		bodyDecodersMu.Unlock()
+	})
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+
+		value string
+		code  string
+	}{
+		{name: "JSON with charset parameter.", contentType: "application/json; charset=utf-8", body: `{"name":"John"}`, value: "John"},
+		{name: "JSON with upper-cased media type.", contentType: "Application/JSON", body: `{"name":"John"}`, value: "John"},
+		{name: "JSON:API vendor type.", contentType: "application/vnd.api+json", body: `{"name":"John"}`, value: "John"},
+		{name: "Problem details type.", contentType: "application/problem+json", body: `{"name":"John"}`, value: "John"},
+		{name: "Url-encoded with charset parameter.", contentType: "application/x-www-form-urlencoded; charset=utf-8", body: `name=John`, value: "John"},
+		{name: "Custom decoder registered with a wildcard.", contentType: "text/plain", body: "name: John\nage: 30", value: "John"},
+		{name: "Empty body has no fields.", contentType: "", body: "", value: ""},
+		{name: "Unsupported content type.", contentType: "application/octet-stream", body: `name`, code: UnsupportedContentTypeCode},
+		{name: "Missing content type.", contentType: "", body: `{"name":"John"}`, code: UnsupportedContentTypeCode},
+		{name: "Malformed JSON.", contentType: "application/json", body: `{"name":`, code: InvalidBodyCode},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var (
+				errs []ValidationChainError
+				md   MatchedData
+			)
+
+			router.POST("/test", NewBodyChain("name", nil).Validate(), func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+				md, _ = GetMatchedData(ctx)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", test.contentType)
+			router.ServeHTTP(w, req)
+
+			if test.code == "" {
+				if len(errs) != 0 {
+					t.Fatalf("expected 0 errors, got %d: %+v", len(errs), errs)
+				}
+
+				if value, _ := md.Get(BodyLocation, "name"); value != test.value {
+					t.Errorf("got %q, want %q", value, test.value)
+				}
+
+				return
+			}
+
+			wantErrs := []ValidationChainError{{Location: "body", Field: "name", Code: test.code}}
+
+			if !cmp.Equal(errs, wantErrs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.IgnoreFields(ValidationChainError{}, "Message")) {
+				t.Errorf("got errors %+v, want %+v", errs, wantErrs)
+			}
+
+			if md.Has(BodyLocation, "name") {
+				t.Error("expected no matched data for a field that could not be extracted")
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package ginvalidator
+
+// allRequestLocations are the locations searched by a chain created with NewCheck without locations, in order.
+var allRequestLocations = []RequestLocation{BodyLocation, CookieLocation, HeaderLocation, ParamLocation, QueryLocation}
+
+// NewCheck constructs a validation chain for a field that may be sent to any of several request locations,
+// e.g. an API key accepted from a header, a query parameter or a cookie.
+//
+// The chain looks for the field in every location, in the order they are given, and validates each value it finds.
+// Errors and matched data are recorded under the location the value was actually found in.
+// A field found in none of the locations is validated as missing from the first one.
+//
+// Parameters:
+//   - field: the name of the field to validate, with the syntax of the location it is looked up in (see [NewBody]).
+//   - errFmtFunc: a handler for formatting error messages.
+//   - locations: the locations to search. It defaults to all of them: body, cookies, headers, params and queries.
+//
+// Example:
+//
+//	ginvalidator.NewCheck("api_key", nil, ginvalidator.HeaderLocation, ginvalidator.QueryLocation, ginvalidator.CookieLocation).Exists()
+func NewCheck(field string, errFmtFunc ErrFmtFunc, locations ...RequestLocation) ValidationChain {
+	if len(locations) == 0 {
+		locations = allRequestLocations
+	}
+	locations = append([]RequestLocation(nil), locations...)
+
+	chain := newValidationChain(field, errFmtFunc, locations[0])
+	chain.validator.locations = locations
+	chain.modifier.locations = locations
+	chain.sanitizer.locations = locations
+
+	return chain
+}
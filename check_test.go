@@ -0,0 +1,136 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestNewCheck(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	apiKey := func() ValidationChain {
+		return NewCheck("api_key", nil, HeaderLocation, QueryLocation, CookieLocation).
+			Exists().
+			Length(&vgo.IsLengthOpts{Min: 4}).WithMessage("api_key is too short")
+	}
+
+	tests := []struct {
+		name    string
+		url     string
+		body    string
+		headers map[string]string
+		cookies []*http.Cookie
+		chain   ValidationChain
+
+		errs []ValidationChainError
+		md   MatchedData
+	}{
+		{
+			name:    "Finds the field in a header.",
+			headers: map[string]string{"api_key": "secret"},
+			chain:   apiKey(),
+			errs:    []ValidationChainError{},
+			md:      MatchedData{"headers": MatchedDataFieldValues{"api_key": "secret"}},
+		},
+		{
+			name:  "Finds the field in a query parameter.",
+			url:   "/test?api_key=abc",
+			chain: apiKey(),
+			errs:  []ValidationChainError{{Location: "queries", Field: "api_key", Value: "abc", Message: "api_key is too short"}},
+			md:    MatchedData{"queries": MatchedDataFieldValues{"api_key": "abc"}},
+		},
+		{
+			name:    "Finds the field in a cookie.",
+			cookies: []*http.Cookie{{Name: "api_key", Value: "secret"}},
+			chain:   apiKey(),
+			errs:    []ValidationChainError{},
+			md:      MatchedData{"cookies": MatchedDataFieldValues{"api_key": "secret"}},
+		},
+		{
+			name:    "Validates every value found, in the order of the locations.",
+			url:     "/test?api_key=abc",
+			headers: map[string]string{"api_key": "xyz"},
+			chain:   apiKey(),
+			errs: []ValidationChainError{
+				{Location: "headers", Field: "api_key", Value: "xyz", Message: "api_key is too short"},
+				{Location: "queries", Field: "api_key", Value: "abc", Message: "api_key is too short"},
+			},
+			md: MatchedData{
+				"headers": MatchedDataFieldValues{"api_key": "xyz"},
+				"queries": MatchedDataFieldValues{"api_key": "abc"},
+			},
+		},
+		{
+			name:  "Reports a missing field under the first location.",
+			chain: apiKey(),
+			errs: []ValidationChainError{
+				{Location: "headers", Field: "api_key", Value: "", Message: "api_key is required", Code: RequiredCode},
+				{Location: "headers", Field: "api_key", Value: "", Message: "api_key is too short"},
+			},
+		},
+		{
+			name:  "Searches every location by default.",
+			body:  `{"token": "t0k3n"}`,
+			chain: NewCheck("token", nil).Exists(),
+			errs:  []ValidationChainError{},
+			md:    MatchedData{"body": MatchedDataFieldValues{"token": "t0k3n"}},
+		},
+		{
+			name:  "Optional skips a field found nowhere.",
+			chain: NewCheck("token", nil).Optional().Length(&vgo.IsLengthOpts{Min: 4}),
+			errs:  []ValidationChainError{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var (
+				errs []ValidationChainError
+				md   MatchedData
+			)
+
+			router.POST("/test", test.chain.Validate(), func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+				md, _ = GetMatchedData(ctx)
+			})
+
+			url := test.url
+			if url == "" {
+				url = "/test"
+			}
+
+			body := test.body
+			if body == "" {
+				body = `{}`
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			for key, value := range test.headers {
+				req.Header.Set(key, value)
+			}
+			for _, cookie := range test.cookies {
+				req.AddCookie(cookie)
+			}
+			router.ServeHTTP(w, req)
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+
+			if !cmp.Equal(md, test.md, cmpopts.EquateEmpty()) {
+				t.Errorf("got matched data %+v, want %+v", md, test.md)
+			}
+		})
+	}
+}
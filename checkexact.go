@@ -0,0 +1,259 @@
+package ginvalidator
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+)
+
+// UnknownFieldCode is the code of the error reported by [CheckExact] for a field none of its chains declares.
+const UnknownFieldCode string = "unknown_field"
+
+// unknownFieldErrMsg is the message of the error reported for an unknown field when no translation is found.
+const unknownFieldErrMsg string = "Unknown field"
+
+// CheckExact runs the validation chains like their Validate middlewares would, then fails the request
+// for every body or query field that none of the chains declares, e.g. a misspelled "emial".
+// Each unknown field is reported as its own [ValidationChainError] with the [UnknownFieldCode] code.
+//
+// Body fields are checked against the keys of a JSON (or decoded) body, down to nested paths:
+// declaring "address.city" allows "address" and "address.city", but not "address.zip".
+// Everything beneath a declared field is allowed, and "*" and "**" segments match like they do in chains.
+// A scalar sent where a declared field expects an object, e.g. "address": "Lagos", is unknown.
+// Form and query fields are checked against their keys, which may be sent under any of the names of a multi-value field
+// (e.g. "tag" also allows "tag[]").
+//
+// Headers, cookies and path params are never unknown.
+//
+// Example:
+//
+//	router.POST("/users",
+//	  ginvalidator.CheckExact(
+//	    ginvalidator.NewBodyChain("email", nil).Email(nil),
+//	    ginvalidator.NewBodyChain("address.city", nil).Alpha(nil),
+//	  ),
+//	  handler,
+//	)
+func CheckExact(chains ...ValidationChain) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !runChains(ctx, chains) {
+			return
+		}
+
+		saveValidationErrorsToCtx(ctx, unknownFieldErrs(ctx, chains))
+		ctx.Next()
+	}
+}
+
+// runChains runs the validation chains in order and saves their results to the context.
+// It returns false when the request was aborted, e.g. by the [InternalErrorHandler].
+func runChains(ctx *gin.Context, chains []ValidationChain) bool {
+	for _, chain := range chains {
+		for _, result := range chain.validate(ctx) {
+			saveChainResultToCtx(ctx, result)
+
+			if ctx.IsAborted() {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// unknownFieldErrs returns the errors of the body and query fields that none of the chains declares.
+func unknownFieldErrs(ctx *gin.Context, chains []ValidationChain) []ValidationChainError {
+	var bodyFields, queryFields []string
+
+	for _, chain := range chains {
+		locations := chain.validator.locations
+		if locations == nil {
+			locations = []RequestLocation{chain.validator.reqLoc}
+		}
+
+		for _, loc := range locations {
+			switch loc {
+			case BodyLocation:
+				bodyFields = append(bodyFields, chain.validator.field)
+			case QueryLocation:
+				queryFields = append(queryFields, chain.validator.field)
+			}
+		}
+	}
+
+	errs := make([]ValidationChainError, 0)
+
+	// A body that cannot be decoded is reported by the chains of its fields, if any.
+	if body, err := getRequestBody(ctx); err == nil {
+		if body.isForm {
+			for _, key := range sortedKeys(body.form) {
+				if !isDeclaredKey(key, bodyFields) {
+					errs = append(errs, newUnknownFieldErr(ctx, BodyLocation, key, body.form.Get(key)))
+				}
+			}
+		} else {
+			for _, field := range unknownJSONFields(body.json, bodyFields, body.implicitArrays) {
+				errs = append(errs, newUnknownFieldErr(ctx, BodyLocation, field.field, field.value))
+			}
+		}
+	}
+
+	query := ctx.Request.URL.Query()
+	for _, key := range sortedKeys(query) {
+		if !isDeclaredKey(key, queryFields) {
+			errs = append(errs, newUnknownFieldErr(ctx, QueryLocation, key, query.Get(key)))
+		}
+	}
+
+	return errs
+}
+
+// sortedKeys returns the keys of form or query values in sorted order, so unknown fields are reported deterministically.
+func sortedKeys(values url.Values) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// isDeclaredKey reports whether a form or query key is one of the names a declared field can be sent under.
+func isDeclaredKey(key string, fields []string) bool {
+	for _, field := range fields {
+		for _, name := range multiValueKeys(field) {
+			if key == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// unknownJSONFields walks a JSON document and returns the outermost values that no declared field covers,
+// in document order. A value is covered when a declared field matches it or one of its ancestors;
+// the objects and arrays leading to a declared field are walked into, every other value is unknown.
+func unknownJSONFields(document gjson.Result, fields []string, implicitArrays bool) []fieldInstance {
+	patterns := make([][]string, len(fields))
+	for i, field := range fields {
+		patterns[i] = splitFieldPath(field)
+	}
+
+	unknown := make([]fieldInstance, 0)
+
+	var walk func(result gjson.Result, path []string, field string)
+
+	walk = func(result gjson.Result, path []string, field string) {
+		forEachChildSegment(result, func(segment, component string, child gjson.Result) {
+			childPath := append(path[:len(path):len(path)], segment)
+			childField := joinConcretePath(field, component)
+
+			leads := false
+			for _, pattern := range patterns {
+				covered, leadsTo := matchFieldPattern(pattern, childPath, implicitArrays)
+				if covered {
+					return
+				}
+				leads = leads || leadsTo
+			}
+
+			// A scalar has nothing beneath it that a declared field could match.
+			if !leads || !(child.IsObject() || child.IsArray()) {
+				unknown = append(unknown, fieldInstance{field: childField, value: child.String(), kind: jsonValueKind(child)})
+				return
+			}
+
+			walk(child, childPath, childField)
+		})
+	}
+
+	walk(document, nil, "")
+
+	return unknown
+}
+
+// forEachChildSegment is like [forEachChild], but also passes the path segment of the child:
+// its index for an array element and its escaped key for an object property.
+func forEachChildSegment(result gjson.Result, fn func(segment, component string, child gjson.Result)) {
+	if result.IsArray() {
+		for i, child := range result.Array() {
+			fn(fmt.Sprint(i), fmt.Sprintf("[%d]", i), child)
+		}
+		return
+	}
+
+	forEachChild(result, func(component string, child gjson.Result) {
+		fn(component, component, child)
+	})
+}
+
+// matchFieldPattern matches the segments of a concrete path against the segments of a declared field.
+// It reports whether the path is covered, i.e. the field matches it or one of its ancestors,
+// and whether the path leads to the field, i.e. it matches the leading segments of the field.
+//
+// With implicitArrays, an index 0 or "*" segment of the field may also match nothing,
+// since it addresses a value that is not an array.
+func matchFieldPattern(pattern, path []string, implicitArrays bool) (covered, leads bool) {
+	if len(pattern) == 0 {
+		return true, false
+	}
+
+	if implicitArrays && (pattern[0] == "0" || pattern[0] == wildcardSegment) {
+		if covered, leads = matchFieldPattern(pattern[1:], path, implicitArrays); covered {
+			return covered, leads
+		}
+	}
+
+	if len(path) == 0 {
+		return onlyGlobstars(pattern), true
+	}
+
+	var c, l bool
+
+	switch pattern[0] {
+	case globstarSegment:
+		// "**" matches any number of segments, including none.
+		c1, l1 := matchFieldPattern(pattern[1:], path, implicitArrays)
+		c2, l2 := matchFieldPattern(pattern, path[1:], implicitArrays)
+		c, l = c1 || c2, l1 || l2
+	case wildcardSegment, path[0]:
+		c, l = matchFieldPattern(pattern[1:], path[1:], implicitArrays)
+	}
+
+	return covered || c, leads || l
+}
+
+// onlyGlobstars reports whether every segment is "**", which then all match nothing.
+func onlyGlobstars(segments []string) bool {
+	for _, segment := range segments {
+		if segment != globstarSegment {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newUnknownFieldErr returns the error reported for an unknown field.
+func newUnknownFieldErr(ctx *gin.Context, loc RequestLocation, field, value string) ValidationChainError {
+	location := loc.String()
+	value = reportedFieldValue(field, value)
+
+	errMsg, ok := translateErrMsg(ctx, messageData{location: location, field: field, value: value, code: UnknownFieldCode})
+	if !ok {
+		errMsg = unknownFieldErrMsg
+	}
+
+	return newValidationChainError(
+		vceWithLocation(location),
+		vceWithMessage(errMsg),
+		vceWithField(field),
+		vceWithValue(value),
+		vceWithCode(UnknownFieldCode),
+	)
+}
@@ -0,0 +1,197 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCheckExact(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name        string
+		url         string
+		body        string
+		contentType string
+		handler     gin.HandlerFunc
+
+		errs []ValidationChainError
+	}{
+		{
+			name:    "Declared fields pass.",
+			url:     "/test?page=2",
+			body:    `{"email": "a@b.com", "address": {"city": "Lagos"}}`,
+			handler: CheckExact(NewBodyChain("email", nil), NewBodyChain("address.city", nil), NewQueryChain("page", nil)),
+			errs:    []ValidationChainError{},
+		},
+		{
+			name:    "Reports a misspelled body field.",
+			body:    `{"emial": "a@b.com"}`,
+			handler: CheckExact(NewBodyChain("email", nil).Optional()),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "emial", Value: "a@b.com", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name:    "Declaring a nested field allows its parent but not its siblings.",
+			body:    `{"address": {"city": "Lagos", "zip": "100001"}}`,
+			handler: CheckExact(NewBodyChain("address.city", nil)),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "address.zip", Value: "100001", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name:    "Everything beneath a declared field is allowed.",
+			body:    `{"address": {"city": "Lagos", "geo": {"lat": 6.5}}}`,
+			handler: CheckExact(NewBodyChain("address", nil)),
+			errs:    []ValidationChainError{},
+		},
+		{
+			name:    "Reports an unknown object once.",
+			body:    `{"name": "Ada", "extra": {"a": 1, "b": 2}}`,
+			handler: CheckExact(NewBodyChain("name", nil)),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "extra", Value: `{"a": 1, "b": 2}`, Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name:    "Wildcards match array elements.",
+			body:    `{"items": [{"sku": "A1"}, {"sku": "B2", "qty": 3}]}`,
+			handler: CheckExact(NewBodyChain("items.*.sku", nil)),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "items[1].qty", Value: "3", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name:    "Globstars match nested values.",
+			body:    `{"tree": {"id": "a", "children": [{"id": "b", "name": "x"}]}}`,
+			handler: CheckExact(NewBodyChain("tree.**.id", nil)),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "tree.children[0].name", Value: "x", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name:    "Reports a scalar where a declared field expects an object.",
+			body:    `{"address": "Lagos"}`,
+			handler: CheckExact(NewBodyChain("address.city", nil).Optional()),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "address", Value: "Lagos", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name:        "Reports unknown form fields.",
+			body:        "name=Ada&tag[]=go&role=admin",
+			contentType: "application/x-www-form-urlencoded",
+			handler:     CheckExact(NewBodyChain("name", nil), NewBodyChain("tag", nil)),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "role", Value: "admin", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name:    "Reports unknown query fields, after the errors of the chains.",
+			url:     "/test?page=x&debug=1",
+			body:    `{}`,
+			handler: CheckExact(NewQueryChain("page", nil).Numeric(nil).WithMessage("page must be numeric")),
+			errs: []ValidationChainError{
+				{Location: "queries", Field: "page", Value: "x", Message: "page must be numeric"},
+				{Location: "queries", Field: "debug", Value: "1", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name:    "Query fields do not declare body fields.",
+			url:     "/test?email=a@b.com",
+			body:    `{"email": "a@b.com"}`,
+			handler: CheckExact(NewQueryChain("email", nil)),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "email", Value: "a@b.com", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name:    "NewCheck declares the field in each of its locations.",
+			url:     "/test?api_key=secret",
+			body:    `{"api_key": "secret", "other": 1}`,
+			handler: CheckExact(NewCheck("api_key", nil, QueryLocation, BodyLocation)),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "other", Value: "1", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+		{
+			name: "CheckSchemaExact declares the schema's fields.",
+			body: `{"email": "a@b.com", "admin": true}`,
+			handler: CheckSchemaExact(Schema{
+				"email": {In: BodyLocation},
+			}),
+			errs: []ValidationChainError{
+				{Location: "body", Field: "admin", Value: "true", Message: unknownFieldErrMsg, Code: UnknownFieldCode},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var errs []ValidationChainError
+
+			router.POST("/test", test.handler, func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+			})
+
+			url := test.url
+			if url == "" {
+				url = "/test"
+			}
+
+			contentType := test.contentType
+			if contentType == "" {
+				contentType = "application/json"
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", contentType)
+			router.ServeHTTP(w, req)
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+		})
+	}
+}
+
+func TestMatchFieldPattern(t *testing.T) {
+	tests := []struct {
+		pattern        string
+		path           []string
+		implicitArrays bool
+
+		covered bool
+		leads   bool
+	}{
+		{pattern: "address.city", path: []string{"address"}, covered: false, leads: true},
+		{pattern: "address.city", path: []string{"address", "city"}, covered: true, leads: false},
+		{pattern: "address.city", path: []string{"address", "city", "name"}, covered: true, leads: false},
+		{pattern: "address.city", path: []string{"address", "zip"}, covered: false, leads: false},
+		{pattern: "items.*.sku", path: []string{"items", "3"}, covered: false, leads: true},
+		{pattern: "items.*.sku", path: []string{"items", "3", "sku"}, covered: true, leads: false},
+		{pattern: "**.id", path: []string{"a", "b", "id"}, covered: true, leads: true},
+		{pattern: "order.item.0.@sku", path: []string{"order", "item", "@sku"}, implicitArrays: true, covered: true, leads: false},
+		{pattern: "order.item.0.@sku", path: []string{"order", "item", "@sku"}, covered: false, leads: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern, func(t *testing.T) {
+			covered, leads := matchFieldPattern(splitFieldPath(test.pattern), test.path, test.implicitArrays)
+
+			if covered != test.covered || leads != test.leads {
+				t.Errorf("path %v: got covered %t and leads %t, want %t and %t", test.path, covered, leads, test.covered, test.leads)
+			}
+		})
+	}
+}
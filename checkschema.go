@@ -9,7 +9,7 @@ import (
 // SchemaField describes how a single field should be validated within a [Schema].
 type SchemaField struct {
 	// In specifies which request location the field comes from (body, cookies,
-	// headers, params, or queries).
+	// headers, params, queries, or files).
 	In RequestLocation
 
 	// ErrFmtFunc is an optional per-field error message formatter.
@@ -46,29 +46,46 @@ type Schema map[string]SchemaField
 //	  handler,
 //	)
 func CheckSchema(schema Schema) gin.HandlerFunc {
+	chains := schema.chains()
+
+	return func(ctx *gin.Context) {
+		if !runChains(ctx, chains) {
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// CheckSchemaExact is like [CheckSchema], but also fails the request for every body or query field
+// the schema does not declare, like [CheckExact].
+func CheckSchemaExact(schema Schema) gin.HandlerFunc {
+	return CheckExact(schema.chains()...)
+}
+
+// chains builds the validation chains of the schema's fields, in sorted field order.
+func (schema Schema) chains() []ValidationChain {
 	fields := make([]string, 0, len(schema))
 	for f := range schema {
 		fields = append(fields, f)
 	}
 	sort.Strings(fields)
 
-	return func(ctx *gin.Context) {
-		for _, field := range fields {
-			sf := schema[field]
-			vc := newValidationChain(field, sf.ErrFmtFunc, sf.In)
+	chains := make([]ValidationChain, 0, len(fields))
 
-			if sf.Optional {
-				vc = vc.Optional()
-			}
+	for _, field := range fields {
+		sf := schema[field]
+		vc := newValidationChain(field, sf.ErrFmtFunc, sf.In)
 
-			if sf.Build != nil {
-				vc = sf.Build(vc)
-			}
+		if sf.Optional {
+			vc = vc.Optional()
+		}
 
-			result := vc.validate(ctx)
-			saveValidationErrorsToCtx(ctx, result.errors)
-			saveMatchedDataToCtx(ctx, result.location, result.field, result.sanitizedValue)
+		if sf.Build != nil {
+			vc = sf.Build(vc)
 		}
-		ctx.Next()
+
+		chains = append(chains, vc)
 	}
+
+	return chains
 }
@@ -0,0 +1,204 @@
+package ginvalidator
+
+import (
+	"fmt"
+	"time"
+
+	vgo "github.com/bube054/validatorgo"
+	san "github.com/bube054/validatorgo/sanitizer"
+	"github.com/gin-gonic/gin"
+)
+
+// Error codes of the validation errors reported by the cross-field validators.
+const (
+	// FieldMismatchCode is the code of the error reported when a field does not equal another field.
+	FieldMismatchCode string = "field_mismatch"
+
+	// NotAfterFieldCode is the code of the error reported when a date is not after the date of another field.
+	NotAfterFieldCode string = "not_after_field"
+
+	// NotBeforeFieldCode is the code of the error reported when a date is not before the date of another field.
+	NotBeforeFieldCode string = "not_before_field"
+
+	// RequiredCode is the code of the error reported when a required field is missing,
+	// either by Exists or because another field requires it.
+	RequiredCode string = "required"
+)
+
+// EqualsField is a validator that checks if the value equals the value of another field of the request,
+// e.g. a password confirmation.
+//
+// The other value is its sanitized value when a chain for that field ran earlier on the route,
+// otherwise its value in the request.
+//
+// Example:
+//
+//	ginvalidator.NewBodyChain("password_confirmation", nil).EqualsField(ginvalidator.BodyLocation, "password")
+func (v validator) EqualsField(loc RequestLocation, field string) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		isValid := sanitizedValue == resolveFieldVal(ctx, loc, field)
+
+		return newValidationChainRule(
+			withIsValid(isValid),
+			withNewValue(sanitizedValue),
+			withValidationChainName(EqualsFieldValidatorName),
+			withValidationChainType(validatorType),
+			withFieldErr(crossFieldErr(isValid, EqualsFieldValidatorName, FieldMismatchCode, "%s must equal %s", field)),
+		)
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, EqualsFieldValidatorName, crossFieldParams(loc, field))
+}
+
+// AfterField is a validator that checks if the value is a date after the date of another field of the request,
+// e.g. an end date after a start date. It fails when either value is not a date.
+//
+// The other value is resolved like in EqualsField.
+//
+// Example:
+//
+//	ginvalidator.NewQueryChain("end_date", nil).AfterField(ginvalidator.QueryLocation, "start_date")
+func (v validator) AfterField(loc RequestLocation, field string) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		date, ok := parseFieldTime(sanitizedValue)
+		otherDate, otherOk := parseFieldTime(resolveFieldVal(ctx, loc, field))
+		isValid := ok && otherOk && date.After(otherDate)
+
+		return newValidationChainRule(
+			withIsValid(isValid),
+			withNewValue(sanitizedValue),
+			withValidationChainName(AfterFieldValidatorName),
+			withValidationChainType(validatorType),
+			withFieldErr(crossFieldErr(isValid, AfterFieldValidatorName, NotAfterFieldCode, "%s must be a date after %s", field)),
+		)
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, AfterFieldValidatorName, crossFieldParams(loc, field))
+}
+
+// BeforeField is a validator that checks if the value is a date before the date of another field of the request.
+// It fails when either value is not a date.
+//
+// The other value is resolved like in EqualsField.
+func (v validator) BeforeField(loc RequestLocation, field string) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		date, ok := parseFieldTime(sanitizedValue)
+		otherDate, otherOk := parseFieldTime(resolveFieldVal(ctx, loc, field))
+		isValid := ok && otherOk && date.Before(otherDate)
+
+		return newValidationChainRule(
+			withIsValid(isValid),
+			withNewValue(sanitizedValue),
+			withValidationChainName(BeforeFieldValidatorName),
+			withValidationChainType(validatorType),
+			withFieldErr(crossFieldErr(isValid, BeforeFieldValidatorName, NotBeforeFieldCode, "%s must be a date before %s", field)),
+		)
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, BeforeFieldValidatorName, crossFieldParams(loc, field))
+}
+
+// RequiredIf is a validator that checks if the value is not empty when another field of the request equals the given value.
+// When the other field has any other value, the validator passes.
+//
+// The other value is resolved like in EqualsField.
+// Do not combine it with Optional, which skips the chain for the very values it checks.
+//
+// Example:
+//
+//	ginvalidator.NewBodyChain("company_name", nil).RequiredIf(ginvalidator.QueryLocation, "type", "business")
+func (v validator) RequiredIf(loc RequestLocation, field, value string) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		isValid := sanitizedValue != "" || resolveFieldVal(ctx, loc, field) != value
+
+		return newValidationChainRule(
+			withIsValid(isValid),
+			withNewValue(sanitizedValue),
+			withValidationChainName(RequiredIfValidatorName),
+			withValidationChainType(validatorType),
+			withFieldErr(crossFieldErr(isValid, RequiredIfValidatorName, RequiredCode, "%s is required when %s is %q", field, value)),
+		)
+	}
+
+	params := crossFieldParams(loc, field)
+	params["otherValue"] = value
+
+	return v.recreateValidationChainFromValidator(ruleCreator, RequiredIfValidatorName, params)
+}
+
+// RequiredWithout is a validator that checks if the value is not empty when another field of the request is missing or empty,
+// e.g. an email when no phone number is given.
+//
+// The other value is resolved like in EqualsField.
+// Do not combine it with Optional, which skips the chain for the very values it checks.
+func (v validator) RequiredWithout(loc RequestLocation, field string) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		isValid := sanitizedValue != "" || resolveFieldVal(ctx, loc, field) != ""
+
+		return newValidationChainRule(
+			withIsValid(isValid),
+			withNewValue(sanitizedValue),
+			withValidationChainName(RequiredWithoutValidatorName),
+			withValidationChainType(validatorType),
+			withFieldErr(crossFieldErr(isValid, RequiredWithoutValidatorName, RequiredCode, "%s is required when %s is missing", field)),
+		)
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, RequiredWithoutValidatorName, crossFieldParams(loc, field))
+}
+
+// resolveFieldVal returns the value of another field of the request.
+//
+// When a chain for that field already ran on the route, its sanitized value from the matched data is used,
+// so e.g. a trimmed password is compared with a trimmed confirmation. Otherwise the value is extracted
+// from the request like any validated field; a field that cannot be extracted resolves to "".
+// Fields sent several times resolve to their first value.
+func resolveFieldVal(ctx *gin.Context, loc RequestLocation, field string) string {
+	if md, err := GetMatchedData(ctx); err == nil {
+		if value, ok := md.Get(loc, field); ok {
+			return value
+		}
+	}
+
+	value, _, err := extractFieldVal(ctx, loc, field)
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
+
+// crossFieldParams returns the params of a cross-field validator, which message templates can refer to
+// as {otherLocation} and {otherField}.
+func crossFieldParams(loc RequestLocation, field string) map[string]any {
+	return newRuleParams(nil, "otherLocation", loc.String(), "otherField", field)
+}
+
+// fieldErrFunc returns the error of a failed validator for the field the chain ran it against,
+// e.g. "items[0].end" for a chain on "items.*.end".
+type fieldErrFunc func(field string) error
+
+// crossFieldErr returns the error of a failed cross-field validator, whose message names both fields:
+// the field of the chain is the first argument of the format, followed by args. It returns nil for a valid value.
+func crossFieldErr(isValid bool, name, code, format string, args ...any) fieldErrFunc {
+	if isValid {
+		return nil
+	}
+
+	return func(field string) error {
+		return &vgo.ValidationError{Validator: name, Code: code, Message: fmt.Sprintf(format, append([]any{field}, args...)...)}
+	}
+}
+
+// parseFieldTime parses a date, either formatted by the ToDate sanitizer or in any format ToDate accepts.
+func parseFieldTime(value string) (time.Time, bool) {
+	if t, err := parseMatchedTime(value); err == nil {
+		return t, true
+	}
+
+	if t := san.ToDate(value); t != nil {
+		return *t, true
+	}
+
+	return time.Time{}, false
+}
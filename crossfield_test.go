@@ -0,0 +1,146 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCrossFieldValidators(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		url    string
+		body   string
+		chains []gin.HandlerFunc
+
+		errs []ValidationChainError
+	}{
+		{
+			name:   "EqualsField passes for equal values.",
+			body:   `{"password": "secret", "password_confirmation": "secret"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("password_confirmation", nil).EqualsField(BodyLocation, "password").Validate()},
+			errs:   []ValidationChainError{},
+		},
+		{
+			name:   "EqualsField names both fields.",
+			body:   `{"password": "secret", "password_confirmation": "secrets"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("password_confirmation", nil).EqualsField(BodyLocation, "password").Validate()},
+			errs: []ValidationChainError{
+				{Location: "body", Field: "password_confirmation", Value: "secrets", Message: "password_confirmation must equal password", Code: FieldMismatchCode},
+			},
+		},
+		{
+			name: "EqualsField uses the sanitized value of a chain that already ran.",
+			body: `{"password": " secret ", "password_confirmation": "secret"}`,
+			chains: []gin.HandlerFunc{
+				NewBodyChain("password", nil).Trim("").Validate(),
+				NewBodyChain("password_confirmation", nil).EqualsField(BodyLocation, "password").Validate(),
+			},
+			errs: []ValidationChainError{},
+		},
+		{
+			name:   "AfterField compares dates from another location.",
+			url:    "/test?start_date=2024-03-01",
+			body:   `{"end_date": "2024-02-28"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("end_date", nil).AfterField(QueryLocation, "start_date").Validate()},
+			errs: []ValidationChainError{
+				{Location: "body", Field: "end_date", Value: "2024-02-28", Message: "end_date must be a date after start_date", Code: NotAfterFieldCode},
+			},
+		},
+		{
+			name:   "AfterField passes for a later date.",
+			url:    "/test?start_date=2024-03-01",
+			body:   `{"end_date": "2024-03-02"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("end_date", nil).AfterField(QueryLocation, "start_date").Validate()},
+			errs:   []ValidationChainError{},
+		},
+		{
+			name:   "AfterField fails when the other field is not a date.",
+			body:   `{"start_date": "soon", "end_date": "2024-03-02"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("end_date", nil).AfterField(BodyLocation, "start_date").Validate()},
+			errs: []ValidationChainError{
+				{Location: "body", Field: "end_date", Value: "2024-03-02", Message: "end_date must be a date after start_date", Code: NotAfterFieldCode},
+			},
+		},
+		{
+			name:   "BeforeField fails for the same date.",
+			body:   `{"start_date": "2024-03-01", "end_date": "2024-03-01"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("start_date", nil).BeforeField(BodyLocation, "end_date").Validate()},
+			errs: []ValidationChainError{
+				{Location: "body", Field: "start_date", Value: "2024-03-01", Message: "start_date must be a date before end_date", Code: NotBeforeFieldCode},
+			},
+		},
+		{
+			name:   "RequiredIf fails when the condition holds.",
+			url:    "/test?type=business",
+			body:   `{}`,
+			chains: []gin.HandlerFunc{NewBodyChain("company_name", nil).RequiredIf(QueryLocation, "type", "business").Validate()},
+			errs: []ValidationChainError{
+				{Location: "body", Field: "company_name", Value: "", Message: `company_name is required when type is "business"`, Code: RequiredCode},
+			},
+		},
+		{
+			name:   "RequiredIf passes when the condition does not hold.",
+			url:    "/test?type=personal",
+			body:   `{}`,
+			chains: []gin.HandlerFunc{NewBodyChain("company_name", nil).RequiredIf(QueryLocation, "type", "business").Validate()},
+			errs:   []ValidationChainError{},
+		},
+		{
+			name:   "RequiredWithout fails when both fields are missing.",
+			body:   `{}`,
+			chains: []gin.HandlerFunc{NewBodyChain("email", nil).RequiredWithout(BodyLocation, "phone").Validate()},
+			errs: []ValidationChainError{
+				{Location: "body", Field: "email", Value: "", Message: "email is required when phone is missing", Code: RequiredCode},
+			},
+		},
+		{
+			name:   "Wildcard chains name the concrete field in their messages.",
+			body:   `{"start": "2024-01-10", "items": [{"end": "2024-02-01"}, {"end": "2024-01-01"}]}`,
+			chains: []gin.HandlerFunc{NewBodyChain("items.*.end", nil).AfterField(BodyLocation, "start").Validate()},
+			errs: []ValidationChainError{
+				{Location: "body", Field: "items[1].end", Value: "2024-01-01", Message: "items[1].end must be a date after start", Code: NotAfterFieldCode},
+			},
+		},
+		{
+			name:   "RequiredWithout passes when the other field is present.",
+			body:   `{"phone": "+15550100"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("email", nil).RequiredWithout(BodyLocation, "phone").Validate()},
+			errs:   []ValidationChainError{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var errs []ValidationChainError
+
+			handlers := append(test.chains, func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+			})
+			router.POST("/test", handlers...)
+
+			url := test.url
+			if url == "" {
+				url = "/test"
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+		})
+	}
+}
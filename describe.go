@@ -0,0 +1,132 @@
+package ginvalidator
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// RuleKind is the kind of a rule of a validation chain.
+type RuleKind int
+
+// Kinds of the rules of a validation chain, in the order of validationChainType.
+const (
+	// ValidatorRule is a validator, e.g. Email.
+	ValidatorRule RuleKind = iota
+
+	// SanitizerRule is a sanitizer, e.g. Trim.
+	SanitizerRule
+
+	// ModifierRule is a modifier, e.g. Bail.
+	ModifierRule
+)
+
+// String returns a string representation of the RuleKind.
+func (k RuleKind) String() string {
+	return [...]string{"validator", "sanitizer", "modifier"}[k]
+}
+
+// RuleDescription describes a rule of a validation chain.
+type RuleDescription struct {
+	Kind    RuleKind       // whether the rule is a validator, a sanitizer or a modifier
+	Name    string         // the name of the rule, e.g. EmailValidatorName
+	Options map[string]any // the arguments and options of the rule in lower camel case, as in message templates, e.g. "min" for Length; nil when it has none
+	Message string         // the message set by WithMessage, if any; a function set by WithMessageFunc is not described
+}
+
+// String returns the rule as it is written in a chain, e.g. `Length(max=64, min=3)`, with its options in order
+// and without those left to their zero value, followed by its message, e.g. `Email().WithMessage("invalid email")`.
+func (r RuleDescription) String() string {
+	keys := make([]string, 0, len(r.Options))
+	for key, value := range r.Options {
+		if value != nil && !reflect.ValueOf(value).IsZero() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	options := make([]string, len(keys))
+	for i, key := range keys {
+		format := "%s=%v"
+		if _, ok := r.Options[key].(string); ok {
+			format = "%s=%q"
+		}
+		options[i] = fmt.Sprintf(format, key, r.Options[key])
+	}
+
+	rule := fmt.Sprintf("%s(%s)", r.Name, strings.Join(options, ", "))
+	if r.Message != "" {
+		rule += fmt.Sprintf(".WithMessage(%q)", r.Message)
+	}
+
+	return rule
+}
+
+// ChainDescription describes a validation chain: the field it validates and its rules, in order.
+type ChainDescription struct {
+	Field     string            // the field of the chain
+	Location  RequestLocation   // the location of the field, the first of Locations for a chain created with NewCheck
+	Locations []RequestLocation // the locations searched by a chain created with NewCheck, nil for any other chain
+	Rules     []RuleDescription // the rules of the chain, in the order they run
+}
+
+// String returns the field of the chain and its rules, e.g. `body.email: Trim() -> Email() -> Bail()`.
+// The locations of a chain created with NewCheck are separated by "|", e.g. `headers|queries.api_key: Alphanumeric()`.
+func (d ChainDescription) String() string {
+	location := d.Location.String()
+	if d.Locations != nil {
+		names := make([]string, len(d.Locations))
+		for i, loc := range d.Locations {
+			names[i] = loc.String()
+		}
+		location = strings.Join(names, "|")
+	}
+
+	if len(d.Rules) == 0 {
+		return location + "." + d.Field
+	}
+
+	rules := make([]string, len(d.Rules))
+	for i, rule := range d.Rules {
+		rules[i] = rule.String()
+	}
+
+	return fmt.Sprintf("%s.%s: %s", location, d.Field, strings.Join(rules, " -> "))
+}
+
+// Describe returns the field, location and rules of the chain, e.g. to log the validation of a route,
+// to generate documentation, or to assert in a test how a route is validated.
+//
+// Example:
+//
+//	chain := ginvalidator.NewBodyChain("email", nil).Trim("").Email(nil).Bail()
+//	fmt.Println(chain.Describe()) // body.email: Trim() -> Email() -> Bail()
+func (v ValidationChain) Describe() ChainDescription {
+	d := ChainDescription{
+		Field:    v.validator.field,
+		Location: v.validator.reqLoc,
+		Rules:    make([]RuleDescription, len(v.validator.ruleDescriptors)),
+	}
+
+	if v.validator.locations != nil {
+		d.Locations = append([]RequestLocation(nil), v.validator.locations...)
+	}
+
+	for i, rd := range v.validator.ruleDescriptors {
+		d.Rules[i] = RuleDescription{
+			Kind:    RuleKind(rd.chainType),
+			Name:    rd.name,
+			Options: maps.Clone(rd.params),
+			Message: rd.message,
+		}
+	}
+
+	return d
+}
+
+// String returns the description of the chain, see [ChainDescription.String].
+func (v ValidationChain) String() string {
+	return v.Describe().String()
+}
@@ -0,0 +1,92 @@
+package ginvalidator
+
+import (
+	"regexp"
+	"testing"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDescribe(t *testing.T) {
+	maxLen := uint(64)
+
+	chain := NewBodyChain("email", nil).
+		Trim("").
+		Email(nil).WithMessage("must be a valid email").
+		Bail().
+		Length(&vgo.IsLengthOpts{Min: 3, Max: &maxLen})
+
+	want := ChainDescription{
+		Field:    "email",
+		Location: BodyLocation,
+		Rules: []RuleDescription{
+			{Kind: SanitizerRule, Name: TrimSanitizerName, Options: map[string]any{"chars": ""}},
+			{Kind: ValidatorRule, Name: EmailValidatorName, Message: "must be a valid email"},
+			{Kind: ModifierRule, Name: BailModifierName},
+			{Kind: ValidatorRule, Name: LengthValidatorName, Options: map[string]any{"min": uint(3), "max": uint(64)}},
+		},
+	}
+
+	got := chain.Describe()
+	if !cmp.Equal(got, want) {
+		t.Errorf("got %+v, want %+v\n%s", got, want, cmp.Diff(want, got))
+	}
+
+	// The description is a copy, which cannot change the chain.
+	got.Rules[3].Options["min"] = uint(10)
+	if min := chain.Describe().Rules[3].Options["min"]; min != uint(3) {
+		t.Errorf("got min %v after changing the description, want 3", min)
+	}
+}
+
+func TestChainDescriptionString(t *testing.T) {
+	tests := []struct {
+		name  string
+		chain ValidationChain
+		want  string
+	}{
+		{
+			name:  "Joins the rules in order.",
+			chain: NewBodyChain("email", nil).Trim("").Email(nil).Bail(),
+			want:  "body.email: Trim() -> Email() -> Bail()",
+		},
+		{
+			name:  "Writes the options that are set and the message.",
+			chain: NewQueryChain("tag", nil).Optional().Contains("go", &vgo.ContainsOpt{MinOccurrences: 2}).WithMessage("needs go twice").Matches(regexp.MustCompile(`^\w+$`)),
+			want:  `queries.tag: Optional() -> Contains(minOccurrences=2, seed="go").WithMessage("needs go twice") -> Matches(re=^\w+$)`,
+		},
+		{
+			name:  "Separates the locations of NewCheck.",
+			chain: NewCheck("api_key", nil, HeaderLocation, QueryLocation).Exists(),
+			want:  `headers|queries.api_key: Exists(absentValues="missing")`,
+		},
+		{
+			name:  "Writes a chain without rules.",
+			chain: NewParamChain("id", nil),
+			want:  "params.id",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.chain.String(); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDescribeRuleNames(t *testing.T) {
+	chain := NewBodyChain("field", nil).Ascii().Date(nil).ISIN().Object(nil).Octal().ToInt()
+
+	got := make([]string, 0)
+	for _, rule := range chain.Describe().Rules {
+		got = append(got, rule.Name)
+	}
+
+	want := []string{AsciiValidatorName, DateValidatorName, ISINValidatorName, ObjectValidatorName, OctalValidatorName, ToIntSanitizerName}
+	if !cmp.Equal(got, want) {
+		t.Errorf("got rules %q, want %q", got, want)
+	}
+}
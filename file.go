@@ -0,0 +1,407 @@
+package ginvalidator
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+)
+
+// Names of the file validators and sanitizer, passed to ErrFmtFunc and used as translation keys.
+const (
+	FileMinSizeValidatorName          string = "FileMinSize"
+	FileMaxSizeValidatorName          string = "FileMaxSize"
+	FileExtensionsValidatorName       string = "FileExtensions"
+	FileMIMETypesValidatorName        string = "FileMIMETypes"
+	FileMatchingMIMETypeValidatorName string = "FileMatchingMIMEType"
+	ImageDimensionsValidatorName      string = "ImageDimensions"
+	SanitizeFilenameSanitizerName     string = "SanitizeFilename"
+)
+
+// Codes of the errors reported by the file validators. A missing file is reported by Exists with [RequiredCode].
+const (
+	// NoFileCode is reported when a file validator runs against a value that is not an uploaded file,
+	// e.g. a field of a file chain without files.
+	NoFileCode string = "no_file"
+
+	// FileTooSmallCode is reported when a file is smaller than its minimum size.
+	FileTooSmallCode string = "file_too_small"
+
+	// FileTooLargeCode is reported when a file is larger than its maximum size.
+	FileTooLargeCode string = "file_too_large"
+
+	// FileExtensionCode is reported when the extension of a file is not allowed.
+	FileExtensionCode string = "file_extension"
+
+	// FileMIMETypeCode is reported when the content of a file is of a type that is not allowed.
+	FileMIMETypeCode string = "file_mime_type"
+
+	// FileMIMEMismatchCode is reported when the Content-Type a file was sent with does not match its content.
+	FileMIMEMismatchCode string = "file_mime_mismatch"
+
+	// ImageDimensionsCode is reported when a file is not an image of the allowed dimensions.
+	ImageDimensionsCode string = "image_dimensions"
+)
+
+// sniffLen is the number of bytes read from a file to detect its MIME type, all that [http.DetectContentType] considers.
+const sniffLen = 512
+
+// maxFilenameLen is the length in bytes of the longest filename SanitizeFilename keeps, the limit of most file systems.
+const maxFilenameLen = 255
+
+// File is used to validate the files uploaded with a "multipart/form-data" body.
+type File struct {
+	field      string     // the field of the files
+	errFmtFunc ErrFmtFunc // the function to create the error message
+}
+
+// Chain initializes a validation chain for the files of the given field.
+//
+// The value of the field is the filename of each file, so the chain validates and matches its files like
+// a form field sent once per file: a field with several files is reported under indexed fields (e.g. "photos[1]"),
+// and ItemCount limits the number of files. The file validators (FileMaxSize, FileMIMETypes, ...) check the files themselves.
+func (f File) Chain() ValidationChain {
+	return newValidationChain(f.field, f.errFmtFunc, FileLocation)
+}
+
+// NewFile constructs a File validator for the given field.
+// Returns a [File] object that can be used to create validation chains.
+//
+// Parameters:
+//   - field: the name of the multipart field the files are uploaded with.
+//   - errFmtFunc: a handler for formatting error messages.
+func NewFile(field string, errFmtFunc ErrFmtFunc) File {
+	return File{
+		field:      field,
+		errFmtFunc: errFmtFunc,
+	}
+}
+
+// NewFileChain is a shorthand for NewFile(field, errFmtFunc).Chain().
+func NewFileChain(field string, errFmtFunc ErrFmtFunc) ValidationChain {
+	return NewFile(field, errFmtFunc).Chain()
+}
+
+// fileCheckFunc checks the file a file validator runs against.
+// It returns the validation error of an invalid file, or err when the file cannot be read.
+type fileCheckFunc func(file *uploadedFile) (*vgo.ValidationError, error)
+
+// uploadedFile is a file being validated, whose content is sniffed at most once.
+type uploadedFile struct {
+	header   *multipart.FileHeader
+	sniffed  bool
+	mimeType string
+	sniffErr error
+}
+
+// ImageDimensionsOpts are the allowed dimensions of the images validated by ImageDimensions, in pixels.
+// A zero bound is not checked.
+type ImageDimensionsOpts struct {
+	MinWidth  int
+	MaxWidth  int
+	MinHeight int
+	MaxHeight int
+}
+
+// extractFileInstance returns the field of a file chain, whose values are the filenames of its files.
+func extractFileInstance(ctx *gin.Context, field string) fieldInstance {
+	instance := fieldInstance{field: field, multiValue: true}
+
+	files, err := getRequestFiles(ctx)
+	if err != nil {
+		instance.err = err
+		return instance
+	}
+
+	for _, header := range files[field] {
+		instance.values = append(instance.values, header.Filename)
+		instance.files = append(instance.files, &uploadedFile{header: header})
+	}
+	instance.kind = valuesKind(instance.values)
+
+	return instance
+}
+
+// extractFieldValFromFiles returns the filename of the first file uploaded under a field.
+func extractFieldValFromFiles(ctx *gin.Context, field string) (string, valueKind, error) {
+	files, err := getRequestFiles(ctx)
+	if err != nil {
+		return "", missingValue, err
+	}
+
+	if len(files[field]) == 0 {
+		return "", missingValue, nil
+	}
+
+	return files[field][0].Filename, stringValue, nil
+}
+
+// fileAt returns the file of the i-th value of a file field, or nil for any other field.
+func (instance fieldInstance) fileAt(i int) *uploadedFile {
+	if i < len(instance.files) {
+		return instance.files[i]
+	}
+
+	return nil
+}
+
+// newFileValidationChainRule returns the rule of a file validator, whose validity is decided by running
+// check against the file being validated, see withCheckedFile.
+func newFileValidationChainRule(name, sanitizedValue string, check fileCheckFunc) validationChainRule {
+	return newValidationChainRule(
+		withNewValue(sanitizedValue),
+		withValidationChainName(name),
+		withValidationChainType(validatorType),
+		withCheckFile(check),
+	)
+}
+
+// withCheckedFile returns the rule of a file validator with the result of its check against the file.
+// A value that is not an uploaded file is invalid.
+func (rule validationChainRule) withCheckedFile(file *uploadedFile) validationChainRule {
+	if file == nil {
+		rule.isValid = false
+		rule.validationErr = &vgo.ValidationError{Validator: rule.validationChainName, Code: NoFileCode, Message: "no file was uploaded"}
+		return rule
+	}
+
+	ve, err := (*rule.checkFile)(file)
+	if ve != nil {
+		rule.validationErr = ve
+	}
+	rule.isValid = ve == nil && err == nil
+	rule.internalErr = err
+
+	return rule
+}
+
+// fileErr returns the validation error of a file validator, or nil if the file is valid.
+func fileErr(isValid bool, name, code, format string, args ...any) *vgo.ValidationError {
+	if isValid {
+		return nil
+	}
+
+	return &vgo.ValidationError{Validator: name, Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// FileMinSize is a validator that checks if the uploaded file is at least size bytes.
+func (v validator) FileMinSize(size int64) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		return newFileValidationChainRule(FileMinSizeValidatorName, sanitizedValue, func(file *uploadedFile) (*vgo.ValidationError, error) {
+			return fileErr(file.header.Size >= size, FileMinSizeValidatorName, FileTooSmallCode, "file must be at least %d bytes", size), nil
+		})
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, FileMinSizeValidatorName, newRuleParams(nil, "size", size))
+}
+
+// FileMaxSize is a validator that checks if the uploaded file is at most size bytes.
+func (v validator) FileMaxSize(size int64) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		return newFileValidationChainRule(FileMaxSizeValidatorName, sanitizedValue, func(file *uploadedFile) (*vgo.ValidationError, error) {
+			return fileErr(file.header.Size <= size, FileMaxSizeValidatorName, FileTooLargeCode, "file must be at most %d bytes", size), nil
+		})
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, FileMaxSizeValidatorName, newRuleParams(nil, "size", size))
+}
+
+// FileExtensions is a validator that checks if the filename of the uploaded file has one of the extensions,
+// compared case-insensitively, e.g. FileExtensions(".jpg", ".png"). The leading dot is optional.
+func (v validator) FileExtensions(extensions ...string) ValidationChain {
+	allowed := make([]string, len(extensions))
+	for i, ext := range extensions {
+		allowed[i] = "." + strings.TrimPrefix(strings.ToLower(ext), ".")
+	}
+
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		return newFileValidationChainRule(FileExtensionsValidatorName, sanitizedValue, func(file *uploadedFile) (*vgo.ValidationError, error) {
+			ext := strings.ToLower(filepath.Ext(sanitizeFilename(file.header.Filename)))
+			return fileErr(slices.Contains(allowed, ext), FileExtensionsValidatorName, FileExtensionCode, "file extension must be one of %s", strings.Join(allowed, ", ")), nil
+		})
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, FileExtensionsValidatorName, newRuleParams(nil, "extensions", strings.Join(allowed, ", ")))
+}
+
+// FileMIMETypes is a validator that checks if the content of the uploaded file is of one of the MIME types,
+// e.g. FileMIMETypes("image/png", "application/pdf"). A type can end with "/*" to allow a whole family, e.g. "image/*".
+//
+// The type is sniffed from the first 512 bytes of the file with [http.DetectContentType], whatever the client declared.
+// Types the sniffer does not know are detected as "application/octet-stream" or "text/plain".
+func (v validator) FileMIMETypes(types ...string) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		return newFileValidationChainRule(FileMIMETypesValidatorName, sanitizedValue, func(file *uploadedFile) (*vgo.ValidationError, error) {
+			mimeType, err := file.detectMIMEType()
+			if err != nil {
+				return nil, err
+			}
+
+			allowed := slices.ContainsFunc(types, func(pattern string) bool {
+				return matchesMIMEType(pattern, mimeType)
+			})
+
+			return fileErr(allowed, FileMIMETypesValidatorName, FileMIMETypeCode, "file type %s is not allowed", mimeType), nil
+		})
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, FileMIMETypesValidatorName, newRuleParams(nil, "types", strings.Join(types, ", ")))
+}
+
+// FileMatchingMIMEType is a validator that checks if the Content-Type the uploaded file was sent with
+// is the type sniffed from its content, so that e.g. an executable declared as "image/png" is rejected.
+func (v validator) FileMatchingMIMEType() ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		return newFileValidationChainRule(FileMatchingMIMETypeValidatorName, sanitizedValue, func(file *uploadedFile) (*vgo.ValidationError, error) {
+			mimeType, err := file.detectMIMEType()
+			if err != nil {
+				return nil, err
+			}
+
+			declared, _, _ := parseContentType(file.header.Header.Get("Content-Type"))
+
+			return fileErr(declared == mimeType, FileMatchingMIMETypeValidatorName, FileMIMEMismatchCode, "file declared as %q is %s", declared, mimeType), nil
+		})
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, FileMatchingMIMETypeValidatorName, nil)
+}
+
+// ImageDimensions is a validator that checks if the uploaded file is an image whose dimensions are within opts.
+// Only the header of the image is read.
+//
+// The image is decoded with [image.DecodeConfig], so its format must be registered with the image package,
+// e.g. by importing image/png. This package registers none, to leave the choice of decoders to the program.
+func (v validator) ImageDimensions(opts *ImageDimensionsOpts) ValidationChain {
+	var bounds ImageDimensionsOpts
+	if opts != nil {
+		bounds = *opts
+	}
+
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		return newFileValidationChainRule(ImageDimensionsValidatorName, sanitizedValue, func(file *uploadedFile) (*vgo.ValidationError, error) {
+			f, err := file.header.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer f.Close()
+
+			config, _, err := image.DecodeConfig(f)
+			if err != nil {
+				return fileErr(false, ImageDimensionsValidatorName, ImageDimensionsCode, "file is not a supported image"), nil
+			}
+
+			valid := withinBounds(config.Width, bounds.MinWidth, bounds.MaxWidth) && withinBounds(config.Height, bounds.MinHeight, bounds.MaxHeight)
+
+			return fileErr(valid, ImageDimensionsValidatorName, ImageDimensionsCode, "image dimensions %dx%d are not allowed", config.Width, config.Height), nil
+		})
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, ImageDimensionsValidatorName, newRuleParams(opts))
+}
+
+// SanitizeFilename is a sanitizer that makes a filename safe to store: directories sent by the client,
+// control characters and characters reserved on common file systems are removed, as are leading and trailing dots and spaces,
+// and the name is shortened to 255 bytes, keeping its extension. An empty name becomes "file".
+//
+// The matched files of a file chain carry their sanitized filename, while errors still report the original one.
+func (s sanitizer) SanitizeFilename() ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		return newValidationChainRule(
+			withNewValue(sanitizeFilename(sanitizedValue)),
+			withValidationChainName(SanitizeFilenameSanitizerName),
+			withValidationChainType(sanitizerType),
+		)
+	}
+
+	return s.recreateValidationChainFromSanitizer(ruleCreator, SanitizeFilenameSanitizerName, nil)
+}
+
+// matchedHeader returns the header of the file as matched data: a copy named after the sanitized value of its field.
+func (f *uploadedFile) matchedHeader(filename string) *multipart.FileHeader {
+	matched := *f.header
+	matched.Filename = filename
+
+	return &matched
+}
+
+// detectMIMEType returns the MIME type sniffed from the first bytes of the file, without its parameters.
+func (f *uploadedFile) detectMIMEType() (string, error) {
+	if f.sniffed {
+		return f.mimeType, f.sniffErr
+	}
+	f.sniffed = true
+
+	file, err := f.header.Open()
+	if err != nil {
+		f.sniffErr = err
+		return "", err
+	}
+	defer file.Close()
+
+	buf := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		f.sniffErr = err
+		return "", err
+	}
+
+	f.mimeType, _, _ = mime.ParseMediaType(http.DetectContentType(buf[:n]))
+
+	return f.mimeType, nil
+}
+
+// matchesMIMEType reports whether a MIME type matches a pattern, which is a type or a family such as "image/*".
+func matchesMIMEType(pattern, mimeType string) bool {
+	pattern = strings.ToLower(pattern)
+
+	if family, ok := strings.CutSuffix(pattern, "/*"); ok {
+		return strings.HasPrefix(mimeType, family+"/")
+	}
+
+	return pattern == mimeType
+}
+
+// withinBounds reports whether n is within min and max, where a zero bound is not checked.
+func withinBounds(n, min, max int) bool {
+	return (min == 0 || n >= min) && (max == 0 || n <= max)
+}
+
+// sanitizeFilename returns a filename that is safe to store, as described by SanitizeFilename.
+func sanitizeFilename(name string) string {
+	// Clients may send a path, with either separator.
+	name = name[strings.LastIndexAny(name, `/\`)+1:]
+
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || strings.ContainsRune(`<>:"|?*`, r) {
+			return -1
+		}
+		return r
+	}, strings.ToValidUTF8(name, ""))
+
+	name = strings.Trim(name, " .")
+	if name == "" {
+		return "file"
+	}
+
+	if len(name) > maxFilenameLen {
+		ext := filepath.Ext(name)
+		if len(ext) > maxFilenameLen/2 {
+			ext = ""
+		}
+
+		name = strings.ToValidUTF8(name[:maxFilenameLen-len(ext)], "") + ext
+	}
+
+	return name
+}
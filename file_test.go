@@ -0,0 +1,387 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// testUpload is a file of a multipart test request.
+type testUpload struct {
+	field       string
+	filename    string
+	contentType string
+	content     []byte
+}
+
+// newMultipartRequest returns a request uploading the files, along with the form values.
+func newMultipartRequest(t *testing.T, values map[string]string, uploads ...testUpload) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for key, value := range values {
+		if err := writer.WriteField(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, upload := range uploads {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, upload.field, upload.filename))
+		header.Set("Content-Type", upload.contentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(upload.content)
+	}
+	writer.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, "/test", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+// testPNG returns a PNG image of the given dimensions.
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestFileChain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	avatar := testUpload{field: "avatar", filename: "me.png", contentType: "image/png", content: testPNG(t, 40, 30)}
+	text := testUpload{field: "avatar", filename: "notes.png", contentType: "image/png", content: []byte("just some text")}
+
+	tests := []struct {
+		name    string
+		uploads []testUpload
+		chain   ValidationChain
+
+		errs  []ValidationChainError
+		names []string
+	}{
+		{
+			name:    "Accepts a valid image.",
+			uploads: []testUpload{avatar},
+			chain: NewFileChain("avatar", nil).Exists().FileMaxSize(1<<20).FileExtensions("png", ".JPG").
+				FileMIMETypes("image/*").FileMatchingMIMEType().ImageDimensions(&ImageDimensionsOpts{MaxWidth: 100, MaxHeight: 100}),
+			errs:  []ValidationChainError{},
+			names: []string{"me.png"},
+		},
+		{
+			name:  "Reports a missing file, and Bail stops the file validators.",
+			chain: NewFileChain("avatar", nil).Exists().Bail().FileMaxSize(10),
+			errs: []ValidationChainError{
+				{Location: "files", Field: "avatar", Message: "avatar is required", Code: RequiredCode},
+			},
+		},
+		{
+			name:  "Reports a file validator without a file.",
+			chain: NewFileChain("avatar", nil).FileMaxSize(10),
+			errs: []ValidationChainError{
+				{Location: "files", Field: "avatar", Message: "no file was uploaded", Code: NoFileCode},
+			},
+		},
+		{
+			name:  "Optional skips a missing file.",
+			chain: NewFileChain("avatar", nil).Optional().Exists(),
+			errs:  []ValidationChainError{},
+		},
+		{
+			name: "Reports the errors of each file under indexed fields, and ItemCount limits the number of files.",
+			uploads: []testUpload{
+				{field: "avatar", filename: "big.png", contentType: "image/png", content: bytes.Repeat([]byte("x"), 200)},
+				{field: "avatar", filename: "small.png", contentType: "image/png", content: []byte("x")},
+			},
+			chain: NewFileChain("avatar", nil).ItemCount(&ItemCountOpts{Min: 1, Max: vgo.Int(1)}).WithMessage("one avatar at most").FileMaxSize(100),
+			errs: []ValidationChainError{
+				{Location: "files", Field: "avatar[0]", Value: "big.png", Message: "file must be at most 100 bytes", Code: FileTooLargeCode},
+				{Location: "files", Field: "avatar", Value: `["big.png","small.png"]`, Message: "one avatar at most"},
+			},
+			names: []string{"big.png", "small.png"},
+		},
+		{
+			name:    "Sniffs the type of the content, whatever was declared.",
+			uploads: []testUpload{text},
+			chain:   NewFileChain("avatar", nil).FileMIMETypes("image/png").FileMatchingMIMEType(),
+			errs: []ValidationChainError{
+				{Location: "files", Field: "avatar", Value: "notes.png", Message: "file type text/plain is not allowed", Code: FileMIMETypeCode},
+				{Location: "files", Field: "avatar", Value: "notes.png", Message: `file declared as "image/png" is text/plain`, Code: FileMIMEMismatchCode},
+			},
+			names: []string{"notes.png"},
+		},
+		{
+			name:    "Checks image dimensions, and Bail stops the validators of the file.",
+			uploads: []testUpload{text, avatar},
+			chain:   NewFileChain("avatar", nil).ImageDimensions(&ImageDimensionsOpts{MinWidth: 50}).Bail().FileMinSize(1 << 20).WithMessage("too small"),
+			errs: []ValidationChainError{
+				{Location: "files", Field: "avatar[0]", Value: "notes.png", Message: "file is not a supported image", Code: ImageDimensionsCode},
+				{Location: "files", Field: "avatar[1]", Value: "me.png", Message: "image dimensions 40x30 are not allowed", Code: ImageDimensionsCode},
+			},
+			names: []string{"notes.png", "me.png"},
+		},
+		{
+			name:    "Checks extensions and sizes.",
+			uploads: []testUpload{{field: "doc", filename: "report.PDF.exe", contentType: "application/pdf", content: []byte("%PDF-1.7")}},
+			chain:   NewFileChain("doc", nil).FileExtensions(".pdf").FileMinSize(10).WithMessage("too small"),
+			errs: []ValidationChainError{
+				{Location: "files", Field: "doc", Value: "report.PDF.exe", Message: "file extension must be one of .pdf", Code: FileExtensionCode},
+				{Location: "files", Field: "doc", Value: "report.PDF.exe", Message: "too small", Code: FileTooSmallCode},
+			},
+			names: []string{"report.PDF.exe"},
+		},
+		{
+			name:    "Sanitizes the matched filename.",
+			uploads: []testUpload{{field: "avatar", filename: `..\..\etc/pass<wd>.png `, contentType: "image/png", content: avatar.content}},
+			chain:   NewFileChain("avatar", nil).SanitizeFilename(),
+			errs:    []ValidationChainError{},
+			names:   []string{"passwd.png"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				errs  []ValidationChainError
+				names []string
+				form  string
+			)
+
+			router := gin.New()
+			router.POST("/test", test.chain.Validate(), func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+				values, _ := GetMatchedValues(ctx)
+				files, _ := values.Files(test.chain.validator.field)
+				for _, file := range files {
+					names = append(names, file.Filename)
+				}
+				form = ctx.PostForm("title")
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, newMultipartRequest(t, map[string]string{"title": "Hello"}, test.uploads...))
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+
+			if !cmp.Equal(names, test.names, cmpopts.EquateEmpty()) {
+				t.Errorf("got matched files %q, want %q", names, test.names)
+			}
+
+			if form != "Hello" {
+				t.Errorf("got form value %q, want the body to still be readable", form)
+			}
+		})
+	}
+}
+
+func TestFileChainInSchemaAndOneOf(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	schema, err := LoadSchema(strings.NewReader(`
+avatar:
+  in: files
+  rules:
+    - name: FileMaxSize
+      args: [4]
+      message: avatar too large
+    - name: FileExtensions
+      args: [[".png"]]
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := schema.chains()[0].String(), `files.avatar: FileMaxSize(size=4).WithMessage("avatar too large") -> FileExtensions(extensions=".png")`; got != want {
+		t.Errorf("got description %q, want %q", got, want)
+	}
+
+	var (
+		schemaErrs []ValidationChainError
+		oneOfErrs  []ValidationChainError
+	)
+
+	router := gin.New()
+	router.POST("/schema", CheckSchema(schema), func(ctx *gin.Context) {
+		schemaErrs, _ = ValidationResult(ctx)
+	})
+	router.POST("/oneof", OneOf([]ValidationChain{NewFileChain("avatar", nil).Exists()}, []ValidationChain{NewBodyChain("url", nil).URL(nil)}), func(ctx *gin.Context) {
+		oneOfErrs, _ = ValidationResult(ctx)
+	})
+
+	upload := testUpload{field: "avatar", filename: "me.gif", contentType: "image/gif", content: []byte("GIF89a")}
+
+	for _, path := range []string{"/schema", "/oneof"} {
+		req := newMultipartRequest(t, nil, upload)
+		req.URL.Path = path
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	want := []ValidationChainError{
+		{Location: "files", Field: "avatar", Value: "me.gif", Message: "avatar too large", Code: FileTooLargeCode},
+		{Location: "files", Field: "avatar", Value: "me.gif", Message: "file extension must be one of .png", Code: FileExtensionCode},
+	}
+	if !cmp.Equal(schemaErrs, want, cmpopts.IgnoreUnexported(ValidationChainError{})) {
+		t.Errorf("got schema errors %+v, want %+v", schemaErrs, want)
+	}
+
+	if len(oneOfErrs) != 0 {
+		t.Errorf("got OneOf errors %+v, want the group of the file to pass", oneOfErrs)
+	}
+}
+
+func TestBindMatchedFiles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type upload struct {
+		Title  string                  `matched:"body:title"`
+		Avatar *multipart.FileHeader   `matched:"files:avatar"`
+		Photos []*multipart.FileHeader `matched:"files:photos"`
+	}
+
+	var (
+		got upload
+		err error
+	)
+
+	router := gin.New()
+	router.POST("/test",
+		NewBodyChain("title", nil).Validate(),
+		NewFileChain("avatar", nil).Validate(),
+		NewFileChain("photos", nil).Validate(),
+		func(ctx *gin.Context) {
+			err = BindMatchedData(ctx, &got)
+		},
+	)
+
+	router.ServeHTTP(httptest.NewRecorder(), newMultipartRequest(t, map[string]string{"title": "Hello"},
+		testUpload{field: "avatar", filename: "me.png", contentType: "image/png", content: []byte("a")},
+		testUpload{field: "photos", filename: "1.png", contentType: "image/png", content: []byte("b")},
+		testUpload{field: "photos", filename: "2.png", contentType: "image/png", content: []byte("c")},
+	))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Title != "Hello" || got.Avatar == nil || got.Avatar.Filename != "me.png" || len(got.Photos) != 2 || got.Photos[1].Filename != "2.png" {
+		t.Errorf("got %+v, want the title, the avatar and two photos", got)
+	}
+}
+
+func TestSanitizeFilename(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "photo.jpg", want: "photo.jpg"},
+		{name: "C:\\Users\\ada\\photo.jpg", want: "photo.jpg"},
+		{name: "../../etc/passwd", want: "passwd"},
+		{name: ".htaccess", want: "htaccess"},
+		{name: "a\x00b|c?.txt", want: "abc.txt"},
+		{name: "...", want: "file"},
+		{name: strings.Repeat("a", 300) + ".txt", want: strings.Repeat("a", 251) + ".txt"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := sanitizeFilename(test.name); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFileChainStreamsLargeFiles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const size = 8 << 20
+
+	// The body is streamed, so that the request itself does not hold the file in memory either.
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+	go func() {
+		writer.WriteField("title", "Holidays")
+		part, _ := writer.CreateFormFile("video", "holidays.mp4")
+		chunk := bytes.Repeat([]byte("a"), 32<<10)
+		for written := 0; written < size; written += len(chunk) {
+			part.Write(chunk)
+		}
+		writer.Close()
+		pw.Close()
+	}()
+
+	var (
+		errs  []ValidationChainError
+		title string
+		files []*multipart.FileHeader
+		stats runtime.MemStats
+	)
+
+	router := gin.New()
+	router.MaxMultipartMemory = 64 << 10
+	router.POST("/test",
+		NewBodyChain("title", nil).Not().Empty(nil).Validate(),
+		NewFileChain("video", nil).Exists().FileMaxSize(size).Validate(),
+		func(ctx *gin.Context) {
+			errs, _ = ValidationResult(ctx)
+			title = ctx.PostForm("title")
+			values, _ := GetMatchedValues(ctx)
+			files, _ = values.Files("video")
+		},
+	)
+
+	req, _ := http.NewRequest(http.MethodPost, "/test", pr)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	allocated := stats.TotalAlloc
+
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	runtime.ReadMemStats(&stats)
+	if allocated = stats.TotalAlloc - allocated; allocated > size/4 {
+		t.Errorf("allocated %d bytes to validate a file of %d bytes, want the file on disk", allocated, size)
+	}
+
+	if len(errs) != 0 || title != "Holidays" || len(files) != 1 || files[0].Size != size {
+		t.Fatalf("got errors %+v, title %q and files %+v, want a valid title and file", errs, title, files)
+	}
+
+	file, err := files[0].Open()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer file.Close()
+
+	if _, ok := file.(*os.File); !ok {
+		t.Errorf("got the file in memory (%T), want it in a temporary file", file)
+	}
+}
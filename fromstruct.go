@@ -0,0 +1,407 @@
+package ginvalidator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StructTagName is the struct tag read by [FromStruct].
+const StructTagName string = "gv"
+
+// OneOfTagName is the tag name of the In validator, as "in" names the request location of a field.
+const OneOfTagName string = "oneof"
+
+// StructAccessor returns the value of a struct type filled with the matched data of the request,
+// as returned by [FromStruct].
+type StructAccessor[T any] func(ctx *gin.Context) (T, error)
+
+// FromStruct compiles the `gv` tags of the fields of the struct type T into validation chains, one per tagged field
+// in the order of the fields, and returns a middleware running them plus an accessor returning a T filled with
+// the matched data of the request.
+//
+// A tag is a comma separated list of keys, modifiers, validators and sanitizers, e.g.
+// `gv:"in=body,path=user.email,optional,trim,email,length=3:64"`:
+//   - in: the request location of the field, written as in [RequestLocation.String] or in singular; body by default.
+//   - path: the field, or gjson path, validated; the name of the field's json tag, or of the field, by default.
+//   - optional: makes the field optional, like the Optional modifier, wherever it is written.
+//   - any other name is the name of a rule registered with [RegisterSchemaRule], e.g. [BailModifierName],
+//     [EmailValidatorName], [TrimSanitizerName] or a custom validator, matched regardless of case and applied
+//     in the order written. The In validator is written "oneof", e.g. oneof=draft|published.
+//
+// Rules with options use their defaults. Rules with arguments take them after an equal sign:
+//   - a range for Length, ByteLength, ItemCount, Int and Float, e.g. length=3:64, int=1: or float=:9.5;
+//   - a string for Contains, Equals, Hash, IBAN, IP, IPRange, IdentityCard, LicensePlate, PassportNumber,
+//     PostalCode, TaxID, UUID, VAT, Whitelisted, the Blacklist, LTrim, RTrim, Trim and Whitelist sanitizers,
+//     and any other rule, whose builder gets it as its only argument;
+//   - a bool for ISRC and the StripLow and ToBoolean sanitizers, false when omitted;
+//   - a number for DivisibleBy, a regular expression for Matches (which therefore cannot contain a comma),
+//     values separated by | for oneof and MobilePhone, and location:field for the field validators,
+//     e.g. equalsfield=body:password or requiredif=body:shipping:true.
+//
+// Rules taking functions, such as CustomValidator or If, cannot be written in a tag: use [NewBodyChain] and the like for those.
+//
+// The accessor binds each tagged field like [BindMatchedData] binds a field tagged `matched:"<in>:<path>"`.
+// Untagged fields and fields tagged "-" are skipped; the tagged fields of embedded structs are compiled as well.
+//
+// FromStruct panics if T is not a struct or a tag is invalid, e.g. names an unknown rule,
+// so that a mistake is found when the routes are set up rather than by a request.
+//
+// Example:
+//
+//	type Signup struct {
+//	  Email string `json:"email" gv:"trim,email,normalizeemail"`
+//	  Age   int    `json:"age" gv:"optional,int=18:,toint"`
+//	  Page  int    `gv:"in=query,path=page,optional,int=1:"`
+//	}
+//
+//	validateSignup, signup := ginvalidator.FromStruct[Signup]()
+//
+//	router.POST("/signup", validateSignup, func(ctx *gin.Context) {
+//	  s, err := signup(ctx)
+//	  ...
+//	})
+func FromStruct[T any]() (gin.HandlerFunc, StructAccessor[T]) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("ginvalidator: FromStruct: %s is not a struct", t))
+	}
+
+	fields, err := compileStructFields(t, nil)
+	if err != nil {
+		panic(fmt.Sprintf("ginvalidator: FromStruct[%s]: %v", t, err))
+	}
+
+	chains := make([]ValidationChain, len(fields))
+	for i, f := range fields {
+		chains[i] = f.chain
+	}
+
+	middleware := func(ctx *gin.Context) {
+		if !runChains(ctx, chains) {
+			return
+		}
+		ctx.Next()
+	}
+
+	accessor := func(ctx *gin.Context) (T, error) {
+		var dst T
+
+		md, err := GetMatchedValues(ctx)
+		if err != nil {
+			return dst, err
+		}
+
+		binder := matchedDataBinder{md: md}
+		rv := reflect.ValueOf(&dst).Elem()
+
+		for _, f := range fields {
+			if _, err := binder.bindField(rv.FieldByIndex(f.index), f.chain.validator.reqLoc, f.chain.validator.field); err != nil {
+				return dst, err
+			}
+		}
+
+		return dst, nil
+	}
+
+	return middleware, accessor
+}
+
+// structField is a tagged field of a struct compiled by [FromStruct].
+type structField struct {
+	index []int           // the index of the field, for reflect.Value.FieldByIndex
+	chain ValidationChain // the chain validating the field
+}
+
+// compileStructFields compiles the tagged fields of a struct type, descending into its untagged embedded structs.
+func compileStructFields(t reflect.Type, index []int) ([]structField, error) {
+	var fields []structField
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		fieldIndex := append(append([]int(nil), index...), i)
+
+		tag, ok := sf.Tag.Lookup(StructTagName)
+		if tag == "-" {
+			continue
+		}
+
+		if !ok {
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				embedded, err := compileStructFields(sf.Type, fieldIndex)
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, embedded...)
+			}
+			continue
+		}
+
+		if !sf.IsExported() {
+			return nil, fmt.Errorf("field %s: tagged field is not exported", sf.Name)
+		}
+
+		if isBindableStruct(sf.Type) {
+			return nil, fmt.Errorf("field %s: struct fields cannot be tagged, tag their fields instead", sf.Name)
+		}
+
+		chain, err := compileStructTag(tag, defaultStructFieldPath(sf))
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", sf.Name, err)
+		}
+
+		fields = append(fields, structField{index: fieldIndex, chain: chain})
+	}
+
+	return fields, nil
+}
+
+// defaultStructFieldPath returns the path of a field whose tag has no path key: the name of its json tag, or its name.
+func defaultStructFieldPath(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	if name != "" && name != "-" {
+		return name
+	}
+
+	return sf.Name
+}
+
+// compileStructTag compiles a `gv` tag into a validation chain.
+func compileStructTag(tag, path string) (ValidationChain, error) {
+	type tagRule struct {
+		name    string
+		rule    SchemaRule
+		builder SchemaRuleBuilder
+	}
+
+	var (
+		loc      = BodyLocation
+		optional bool
+		rules    []tagRule
+	)
+
+	for _, item := range strings.Split(tag, ",") {
+		name, arg, hasArg := strings.Cut(strings.TrimSpace(item), "=")
+
+		switch key := strings.ToLower(name); key {
+		case "":
+			return ValidationChain{}, fmt.Errorf("tag %q has an empty rule", tag)
+		case "in":
+			l, ok := parseRequestLocation(arg)
+			if !ok {
+				return ValidationChain{}, fmt.Errorf("unknown request location %q", arg)
+			}
+			loc = l
+		case "path":
+			if arg == "" {
+				return ValidationChain{}, fmt.Errorf("tag %q names no path", tag)
+			}
+			path = arg
+		case strings.ToLower(OptionalModifierName):
+			if arg != "" {
+				return ValidationChain{}, fmt.Errorf("%s takes no argument", name)
+			}
+			optional = true
+		default:
+			ruleName := name
+			if key == OneOfTagName {
+				ruleName = InValidatorName
+			}
+
+			builder, ok := LookupSchemaRule(ruleName)
+			if !ok {
+				return ValidationChain{}, fmt.Errorf("unknown rule %q", name)
+			}
+
+			rule := SchemaRule{Name: ruleName}
+			if parseArg, ok := tagArgSyntaxes[strings.ToLower(ruleName)]; ok {
+				if err := parseArg(&rule, arg); err != nil {
+					return ValidationChain{}, fmt.Errorf("%s: %w", name, err)
+				}
+			} else if hasArg {
+				rule.Args = []any{arg}
+			}
+
+			rules = append(rules, tagRule{name: name, rule: rule, builder: builder})
+		}
+	}
+
+	vc := newValidationChain(path, nil, loc)
+
+	if optional {
+		vc = vc.Optional()
+	}
+
+	for _, r := range rules {
+		var err error
+		if vc, err = r.builder(vc, r.rule); err != nil {
+			return ValidationChain{}, fmt.Errorf("%s: %w", r.name, err)
+		}
+	}
+
+	return vc, nil
+}
+
+// tagArgSyntax parses the argument written after the name of a rule in a `gv` tag into the arguments
+// and options of the rule, for the builder registered under its name with [RegisterSchemaRule].
+type tagArgSyntax func(rule *SchemaRule, arg string) error
+
+// tagArgSyntaxes maps the lowercase names of the rules whose tag argument is not a single string to its syntax.
+// The argument of any other rule, when written, is its only argument.
+var tagArgSyntaxes = func() map[string]tagArgSyntax {
+	syntaxes := map[string]tagArgSyntax{
+		LengthValidatorName:     rangeTagArg[uint],
+		ByteLengthValidatorName: rangeTagArg[uint],
+		ItemCountValidatorName:  rangeTagArg[int],
+		IntValidatorName:        rangeTagArg[int],
+		FloatValidatorName:      rangeTagArg[float64],
+
+		ISRCValidatorName:      boolTagArg,
+		StripLowSanitizerName:  boolTagArg,
+		ToBooleanSanitizerName: boolTagArg,
+
+		InValidatorName: func(rule *SchemaRule, arg string) error {
+			if arg == "" {
+				return fmt.Errorf("expected values separated by |")
+			}
+			rule.Args = []any{strings.Split(arg, "|")}
+			return nil
+		},
+		MobilePhoneValidatorName: func(rule *SchemaRule, arg string) error {
+			var locales []string
+			if arg != "" {
+				locales = strings.Split(arg, "|")
+			}
+			rule.Args = []any{locales}
+			return nil
+		},
+		DivisibleByValidatorName: func(rule *SchemaRule, arg string) error {
+			num, err := strconv.Atoi(arg)
+			if err != nil {
+				return fmt.Errorf("expected an integer, got %q", arg)
+			}
+			rule.Args = []any{num}
+			return nil
+		},
+
+		EqualsFieldValidatorName:     fieldTagArg,
+		AfterFieldValidatorName:      fieldTagArg,
+		BeforeFieldValidatorName:     fieldTagArg,
+		RequiredWithoutValidatorName: fieldTagArg,
+		RequiredIfValidatorName: func(rule *SchemaRule, arg string) error {
+			locName, rest, _ := strings.Cut(arg, ":")
+			field, value, ok := strings.Cut(rest, ":")
+			if _, locOk := parseRequestLocation(locName); !ok || !locOk || field == "" {
+				return fmt.Errorf("expected location:field:value, got %q", arg)
+			}
+			rule.Args = []any{locName, field, value}
+			return nil
+		},
+	}
+
+	lower := make(map[string]tagArgSyntax, len(syntaxes))
+	for name, syntax := range syntaxes {
+		lower[strings.ToLower(name)] = syntax
+	}
+
+	return lower
+}()
+
+// rangeTagArg parses a min:max range into the min and max options of the rule.
+func rangeTagArg[N int | uint | float64](rule *SchemaRule, arg string) error {
+	min, max, err := parseTagRange[N](arg)
+	if err != nil {
+		return err
+	}
+
+	rule.Options = make(map[string]any)
+	if min != nil {
+		rule.Options["min"] = *min
+	}
+	if max != nil {
+		rule.Options["max"] = *max
+	}
+
+	return nil
+}
+
+// boolTagArg parses a bool into the only argument of the rule, false when the argument is omitted.
+func boolTagArg(rule *SchemaRule, arg string) error {
+	if arg == "" {
+		rule.Args = []any{false}
+		return nil
+	}
+
+	b, err := strconv.ParseBool(arg)
+	if err != nil {
+		return fmt.Errorf("expected a bool, got %q", arg)
+	}
+
+	rule.Args = []any{b}
+	return nil
+}
+
+// fieldTagArg parses the other field of a rule comparing with it, written as location:field, into its arguments.
+func fieldTagArg(rule *SchemaRule, arg string) error {
+	locName, field, _ := strings.Cut(arg, ":")
+	if _, ok := parseRequestLocation(locName); !ok || field == "" {
+		return fmt.Errorf("expected location:field, got %q", arg)
+	}
+
+	rule.Args = []any{locName, field}
+	return nil
+}
+
+// parseTagRange parses a min:max range, where either bound may be omitted, e.g. 3:64, 3: or :64.
+// A single number is a minimum.
+func parseTagRange[N int | uint | float64](arg string) (min, max *N, err error) {
+	minArg, maxArg, _ := strings.Cut(arg, ":")
+	if minArg == "" && maxArg == "" {
+		return nil, nil, fmt.Errorf("expected a min:max range, got %q", arg)
+	}
+
+	if minArg != "" {
+		if min, err = parseTagNumber[N](minArg); err != nil {
+			return nil, nil, fmt.Errorf("expected a min:max range, got %q", arg)
+		}
+	}
+
+	if maxArg != "" {
+		if max, err = parseTagNumber[N](maxArg); err != nil {
+			return nil, nil, fmt.Errorf("expected a min:max range, got %q", arg)
+		}
+	}
+
+	return min, max, nil
+}
+
+// parseTagNumber parses a bound of a range.
+func parseTagNumber[N int | uint | float64](s string) (*N, error) {
+	var n N
+
+	switch p := any(&n).(type) {
+	case *int:
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		*p = v
+	case *uint:
+		v, err := strconv.ParseUint(s, 10, 0)
+		if err != nil {
+			return nil, err
+		}
+		*p = uint(v)
+	case *float64:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		*p = v
+	}
+
+	return &n, nil
+}
@@ -0,0 +1,202 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+)
+
+type testPaging struct {
+	Page int `gv:"in=query,path=page,optional,int=1:,toint"`
+}
+
+type testSignup struct {
+	testPaging
+
+	Email    string `json:"email" gv:"trim,email"`
+	Name     string `gv:"path=user.name,Length=2:8"`
+	Nickname string `json:"nickname,omitempty" gv:"optional,trim"`
+	Role     string `gv:"path=role,oneof=admin|member"`
+	Internal string `gv:"-"`
+	Ignored  string
+}
+
+func TestFromStruct(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	validate, signup := FromStruct[testSignup]()
+
+	tests := []struct {
+		name   string
+		target string
+		body   string
+
+		fields []string
+		want   testSignup
+	}{
+		{
+			name:   "Fills the struct with the sanitized values.",
+			target: "/test?page=3",
+			body:   `{"email": " ada@example.com ", "user": {"name": "Ada"}, "nickname": " ada ", "role": "admin", "Internal": "x"}`,
+			want:   testSignup{testPaging: testPaging{Page: 3}, Email: "ada@example.com", Name: "Ada", Nickname: "ada", Role: "admin"},
+		},
+		{
+			name:   "Reports the fields failing their tags, and leaves out optional fields.",
+			target: "/test",
+			body:   `{"email": "ada", "user": {"name": "A"}, "role": "owner"}`,
+			fields: []string{"email", "user.name", "role"},
+			want:   testSignup{Email: "ada", Name: "A", Role: "owner"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				fields []string
+				got    testSignup
+				err    error
+			)
+
+			router := gin.New()
+			router.POST("/test", validate, func(ctx *gin.Context) {
+				errs, _ := ValidationResult(ctx)
+				for _, e := range errs {
+					fields = append(fields, e.Field)
+				}
+				got, err = signup(ctx)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, test.target, bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !cmp.Equal(fields, test.fields) {
+				t.Errorf("got errors for fields %q, want %q", fields, test.fields)
+			}
+
+			if got != test.want {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCompileStructTag(t *testing.T) {
+	vc, err := compileStructTag("in=header,path=X-Count,bail,int=1:10,length=:3", "Count")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if vc.validator.reqLoc != HeaderLocation || vc.validator.field != "X-Count" {
+		t.Errorf("got %s %q, want the headers X-Count", vc.validator.reqLoc, vc.validator.field)
+	}
+
+	want := []string{BailModifierName, IntValidatorName, LengthValidatorName}
+	if got := ruleNames(vc); !cmp.Equal(got, want) {
+		t.Errorf("got rules %q, want %q", got, want)
+	}
+
+	length := vc.validator.ruleDescriptors[2]
+	if want := map[string]any{"min": uint(0), "max": uint(3)}; !cmp.Equal(length.params, want) {
+		t.Errorf("got Length params %v, want %v", length.params, want)
+	}
+
+	// The rules registered for schema documents can be written in a tag, with their argument.
+	RegisterSchemaRule("NotReserved", func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+		var reserved string
+		if err := rule.DecodeArgs(&reserved); err != nil {
+			return vc, err
+		}
+		return vc.Not().Equals(reserved), nil
+	})
+
+	vc, err = compileStructTag("trim,notreserved=admin,oneof=admin|user", "role")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want = []string{TrimSanitizerName, NotModifierName, EqualsValidatorName, InValidatorName}
+	if got := ruleNames(vc); !cmp.Equal(got, want) {
+		t.Errorf("got rules %q, want %q", got, want)
+	}
+
+	errTests := []struct {
+		tag string
+		err string
+	}{
+		{tag: "emial", err: `unknown rule "emial"`},
+		{tag: "in=body,in=nowhere", err: `unknown request location "nowhere"`},
+		{tag: "path=", err: "names no path"},
+		{tag: "email,,trim", err: "empty rule"},
+		{tag: "email=strict", err: "email: expected 0 arguments, got 1"},
+		{tag: "length=a:b", err: `length: expected a min:max range, got "a:b"`},
+		{tag: "length=-1", err: "expected a min:max range"},
+		{tag: "int", err: "expected a min:max range"},
+		{tag: "matches=[", err: "matches: error parsing regexp"},
+		{tag: "divisibleby=two", err: "expected an integer"},
+		{tag: "equalsfield=password", err: "expected location:field"},
+		{tag: "customvalidator", err: `unknown rule "customvalidator"`},
+	}
+
+	for _, test := range errTests {
+		t.Run(test.tag, func(t *testing.T) {
+			_, err := compileStructTag(test.tag, "field")
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, want it to contain %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestFromStructPanics(t *testing.T) {
+	type unknownRule struct {
+		Email string `gv:"emial"`
+	}
+
+	type nested struct {
+		Address struct {
+			City string
+		} `gv:"optional"`
+	}
+
+	tests := []struct {
+		name     string
+		from     func()
+		contains string
+	}{
+		{name: "unknown rule", from: func() { FromStruct[unknownRule]() }, contains: `field Email: unknown rule "emial"`},
+		{name: "not a struct", from: func() { FromStruct[string]() }, contains: "string is not a struct"},
+		{name: "struct field", from: func() { FromStruct[nested]() }, contains: "field Address: struct fields cannot be tagged"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if msg, _ := r.(string); !strings.Contains(msg, test.contains) {
+					t.Errorf("got panic %v, want it to contain %q", r, test.contains)
+				}
+			}()
+
+			test.from()
+		})
+	}
+}
+
+// ruleNames returns the names of the rules of a chain, in order.
+func ruleNames(vc ValidationChain) []string {
+	names := make([]string, len(vc.validator.ruleDescriptors))
+	for i, rd := range vc.validator.ruleDescriptors {
+		names[i] = rd.name
+	}
+	return names
+}
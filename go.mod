@@ -6,9 +6,12 @@ toolchain go1.24.4
 
 require (
 	github.com/bube054/validatorgo v1.0.0
+	github.com/dlclark/regexp2 v1.11.5
 	github.com/gin-gonic/gin v1.10.1
 	github.com/google/go-cmp v0.6.0
+	github.com/santhosh-tekuri/jsonschema/v6 v6.0.2
 	github.com/tidwall/gjson v1.19.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -39,5 +42,4 @@ require (
 	golang.org/x/sys v0.26.0 // indirect
 	golang.org/x/text v0.19.0 // indirect
 	google.golang.org/protobuf v1.35.1 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
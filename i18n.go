@@ -0,0 +1,452 @@
+package ginvalidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultMessageKey is the catalog key of the message used when neither the validator nor the error code has a message.
+const DefaultMessageKey string = "default"
+
+// ginValidatorCtxLocaleStoreName is the key, where the locale resolved for a request is cached.
+const ginValidatorCtxLocaleStoreName string = "__ginvalidator__ctx__locale__"
+
+// MessageCatalog holds the error message templates of a locale.
+//
+// For a failed validator, the keys are looked up in this order:
+//   - "<validator>.<code>", e.g. "Length.too_short".
+//   - "<validator>", e.g. "Email".
+//   - "<code>", e.g. "invalid_format". Extraction errors (e.g. "invalid_body") only have a code.
+//   - [DefaultMessageKey].
+//
+// Templates can contain placeholders, which are replaced when the error is created:
+// {field}, {value}, {location}, {validator}, {code} and the options and arguments of the validator
+// in lower camel case, e.g. {min} and {max} for Length or {comparison} for Equals.
+// Unknown placeholders are kept as is.
+type MessageCatalog map[string]string
+
+// LocaleResolverFunc returns the locale error messages of a request are translated to, e.g. "pt-BR".
+type LocaleResolverFunc func(ctx *gin.Context) string
+
+// messageData holds the values a message template is rendered with.
+type messageData struct {
+	location  string
+	field     string
+	value     string
+	validator string
+	code      string
+	params    map[string]any
+}
+
+var (
+	messageCatalogsMu sync.RWMutex
+	messageCatalogs   = map[string]MessageCatalog{}
+	localeFallbacks   = map[string][]string{}
+	defaultLocale     string
+	localeResolver    LocaleResolverFunc
+)
+
+// messagePlaceholder matches the placeholders of a message template, e.g. "{field}".
+var messagePlaceholder = regexp.MustCompile(`\{(\w+)\}`)
+
+// RegisterMessageCatalog registers the error messages of a locale, e.g. "en" or "pt-BR".
+// Messages are merged into the catalog already registered for the locale, replacing existing keys.
+// Locales are matched case-insensitively and "_" is treated as "-".
+//
+// Example:
+//
+//	ginvalidator.RegisterMessageCatalog("fr", ginvalidator.MessageCatalog{
+//	  "Email":   "{field} doit être une adresse e-mail valide",
+//	  "Length":  "{field} doit contenir entre {min} et {max} caractères",
+//	  "default": "{field} est invalide",
+//	})
+func RegisterMessageCatalog(locale string, catalog MessageCatalog) {
+	messageCatalogsMu.Lock()
+	defer messageCatalogsMu.Unlock()
+
+	locale = normalizeLocale(locale)
+
+	merged, ok := messageCatalogs[locale]
+	if !ok {
+		merged = make(MessageCatalog, len(catalog))
+		messageCatalogs[locale] = merged
+	}
+
+	for key, message := range catalog {
+		merged[key] = message
+	}
+}
+
+// LoadMessageCatalogs registers the message catalogs of the files matching the given [fs.Glob] patterns,
+// typically from an [embed.FS]. The locale of a file is its base name without extension, e.g. "pt-BR.yaml".
+//
+// Files can be JSON (".json") or YAML (".yaml" or ".yml"). Nested objects are flattened by joining keys with ".",
+// so {"Length": {"too_short": "..."}} registers the key "Length.too_short".
+//
+// Example:
+//
+//	//go:embed locales/*.json
+//	var locales embed.FS
+//
+//	if err := ginvalidator.LoadMessageCatalogs(locales, "locales/*.json"); err != nil {
+//	  log.Fatal(err)
+//	}
+func LoadMessageCatalogs(fsys fs.FS, patterns ...string) error {
+	for _, pattern := range patterns {
+		names, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return err
+		}
+
+		if len(names) == 0 {
+			return fmt.Errorf("no message catalog matches %q", pattern)
+		}
+
+		for _, name := range names {
+			catalog, err := readMessageCatalog(fsys, name)
+			if err != nil {
+				return fmt.Errorf("message catalog %q: %w", name, err)
+			}
+
+			RegisterMessageCatalog(strings.TrimSuffix(path.Base(name), path.Ext(name)), catalog)
+		}
+	}
+
+	return nil
+}
+
+// readMessageCatalog reads and flattens a JSON or YAML message catalog file.
+func readMessageCatalog(fsys fs.FS, name string) (MessageCatalog, error) {
+	raw, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]any
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		err = json.Unmarshal(raw, &doc)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(raw, &doc)
+	default:
+		return nil, fmt.Errorf("unsupported file extension %q", path.Ext(name))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	catalog := make(MessageCatalog)
+	if err := flattenMessageCatalog(catalog, "", doc); err != nil {
+		return nil, err
+	}
+
+	return catalog, nil
+}
+
+// flattenMessageCatalog adds the messages of a decoded catalog document, joining nested keys with ".".
+func flattenMessageCatalog(catalog MessageCatalog, prefix string, doc map[string]any) error {
+	for key, value := range doc {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		switch v := value.(type) {
+		case string:
+			catalog[key] = v
+		case map[string]any:
+			if err := flattenMessageCatalog(catalog, key, v); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("key %q: message must be a string, got %T", key, value)
+		}
+	}
+
+	return nil
+}
+
+// SetDefaultLocale sets the locale used when no locale of a request has a catalog,
+// and the last fallback of every locale.
+func SetDefaultLocale(locale string) {
+	messageCatalogsMu.Lock()
+	defer messageCatalogsMu.Unlock()
+
+	defaultLocale = normalizeLocale(locale)
+}
+
+// SetLocaleFallbacks sets the locales tried, in order, when a message is missing from the catalog of a locale.
+// Parent locales (e.g. "pt" for "pt-BR") and then the default locale are always tried after them.
+//
+// Example:
+//
+//	ginvalidator.SetLocaleFallbacks("gl", "es", "pt")
+func SetLocaleFallbacks(locale string, fallbacks ...string) {
+	messageCatalogsMu.Lock()
+	defer messageCatalogsMu.Unlock()
+
+	normalized := make([]string, len(fallbacks))
+	for i, fallback := range fallbacks {
+		normalized[i] = normalizeLocale(fallback)
+	}
+
+	localeFallbacks[normalizeLocale(locale)] = normalized
+}
+
+// SetLocaleResolver sets the function choosing the locale of a request, e.g. from a query parameter,
+// a cookie or the authenticated user. A nil resolver restores the default, which negotiates the locale
+// from the Accept-Language header. When the resolver returns "", the default locale is used.
+func SetLocaleResolver(resolver LocaleResolverFunc) {
+	messageCatalogsMu.Lock()
+	defer messageCatalogsMu.Unlock()
+
+	localeResolver = resolver
+}
+
+// translateErrMsg renders the message of the catalogs of the request locale matching the given data.
+// It reports false when no catalog has a message for it.
+func translateErrMsg(ctx *gin.Context, data messageData) (string, bool) {
+	messageCatalogsMu.RLock()
+	noCatalogs := len(messageCatalogs) == 0
+	messageCatalogsMu.RUnlock()
+
+	if noCatalogs {
+		return "", false
+	}
+
+	locale := requestLocale(ctx)
+
+	messageCatalogsMu.RLock()
+	defer messageCatalogsMu.RUnlock()
+
+	for _, candidate := range localeChain(locale) {
+		catalog, ok := messageCatalogs[candidate]
+		if !ok {
+			continue
+		}
+
+		for _, key := range messageKeys(data.validator, data.code) {
+			if template, ok := catalog[key]; ok {
+				return renderMessage(template, data), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// messageKeys lists the catalog keys of a message, from most to least specific.
+func messageKeys(validator, code string) []string {
+	keys := make([]string, 0, 4)
+
+	if validator != "" && code != "" {
+		keys = append(keys, validator+"."+code)
+	}
+	if validator != "" {
+		keys = append(keys, validator)
+	}
+	if code != "" {
+		keys = append(keys, code)
+	}
+
+	return append(keys, DefaultMessageKey)
+}
+
+// renderMessage replaces the placeholders of a message template.
+func renderMessage(template string, data messageData) string {
+	return messagePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+
+		switch name {
+		case "field":
+			return data.field
+		case "value":
+			return data.value
+		case "location":
+			return data.location
+		case "validator":
+			return data.validator
+		case "code":
+			return data.code
+		}
+
+		if param, ok := data.params[name]; ok {
+			return fmt.Sprint(param)
+		}
+
+		return placeholder
+	})
+}
+
+// requestLocale returns the locale of a request, resolving it once per request.
+func requestLocale(ctx *gin.Context) string {
+	if ctx == nil {
+		return getDefaultLocale()
+	}
+
+	if locale, ok := ctx.Get(ginValidatorCtxLocaleStoreName); ok {
+		if locale, ok := locale.(string); ok {
+			return locale
+		}
+	}
+
+	messageCatalogsMu.RLock()
+	resolver := localeResolver
+	messageCatalogsMu.RUnlock()
+
+	var locale string
+	if resolver != nil {
+		locale = normalizeLocale(resolver(ctx))
+	} else if ctx.Request != nil {
+		locale = negotiateLocale(ctx.GetHeader("Accept-Language"))
+	}
+
+	if locale == "" {
+		locale = getDefaultLocale()
+	}
+
+	ctx.Set(ginValidatorCtxLocaleStoreName, locale)
+
+	return locale
+}
+
+// getDefaultLocale returns the default locale.
+func getDefaultLocale() string {
+	messageCatalogsMu.RLock()
+	defer messageCatalogsMu.RUnlock()
+
+	return defaultLocale
+}
+
+// negotiateLocale returns the most preferred locale of an Accept-Language header that has a catalog,
+// directly or through its fallbacks and parents. It returns "" when none has.
+func negotiateLocale(acceptLanguage string) string {
+	messageCatalogsMu.RLock()
+	defer messageCatalogsMu.RUnlock()
+
+	for _, locale := range parseAcceptLanguage(acceptLanguage) {
+		for _, candidate := range localeCandidates(locale) {
+			if _, ok := messageCatalogs[candidate]; ok {
+				return locale
+			}
+		}
+	}
+
+	return ""
+}
+
+// localeCandidates lists a locale, its explicit fallbacks and its parent locales, in that order.
+// The caller must hold messageCatalogsMu.
+func localeCandidates(locale string) []string {
+	candidates := []string{locale}
+	for _, fallback := range localeFallbacks[locale] {
+		candidates = append(candidates, parentLocales(fallback)...)
+	}
+
+	return append(candidates, parentLocales(locale)...)
+}
+
+// localeChain lists the locales whose catalogs are searched for a locale: its candidates
+// followed by the default locale and its parents. The caller must hold messageCatalogsMu.
+func localeChain(locale string) []string {
+	defaults := parentLocales(defaultLocale)
+
+	// The default locale always comes last, so it never shadows a more specific fallback.
+	seen := make(map[string]bool)
+	for _, l := range defaults {
+		seen[l] = true
+	}
+
+	var chain []string
+	for _, candidate := range localeCandidates(locale) {
+		if candidate != "" && !seen[candidate] {
+			seen[candidate] = true
+			chain = append(chain, candidate)
+		}
+	}
+
+	return append(chain, defaults...)
+}
+
+// parentLocales returns a locale followed by its parents, e.g. "zh-hant-tw", "zh-hant" and "zh".
+func parentLocales(locale string) []string {
+	if locale == "" {
+		return nil
+	}
+
+	locales := []string{locale}
+	for {
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			return locales
+		}
+		locale = locale[:i]
+		locales = append(locales, locale)
+	}
+}
+
+// parseAcceptLanguage returns the normalized language tags of an Accept-Language header, by decreasing quality.
+// Tags with a quality of 0 and the "*" wildcard are left out.
+func parseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weightedTag
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = normalizeLocale(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range strings.Split(params, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(key) != "q" {
+				continue
+			}
+
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				q = 0
+			}
+			quality = q
+		}
+
+		if quality <= 0 {
+			continue
+		}
+
+		tags = append(tags, weightedTag{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].quality > tags[j].quality
+	})
+
+	locales := make([]string, len(tags))
+	for i, tag := range tags {
+		locales[i] = tag.tag
+	}
+
+	return locales
+}
+
+// normalizeLocale lower-cases a locale and replaces "_" with "-", so "pt_BR" and "pt-br" match "pt-BR".
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
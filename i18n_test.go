@@ -0,0 +1,221 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+)
+
+// resetMessageCatalogs restores the translation settings once the test is done.
+func resetMessageCatalogs(t *testing.T) {
+	t.Cleanup(func() {
+		messageCatalogsMu.Lock()
+		defer messageCatalogsMu.Unlock()
+
+		messageCatalogs = map[string]MessageCatalog{}
+		localeFallbacks = map[string][]string{}
+		defaultLocale = ""
+		localeResolver = nil
+	})
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{header: "", want: []string{}},
+		{header: "fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5", want: []string{"fr-ch", "fr", "en", "de"}},
+		{header: "en;q=0.5, pt_BR", want: []string{"pt-br", "en"}},
+		{header: "de;q=0, es;q=0.3, it;q=0.3", want: []string{"es", "it"}},
+		{header: "ja;q=abc, ko", want: []string{"ko"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.header, func(t *testing.T) {
+			got := parseAcceptLanguage(test.header)
+
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLocaleChain(t *testing.T) {
+	resetMessageCatalogs(t)
+
+	SetDefaultLocale("en-US")
+	SetLocaleFallbacks("gl", "es-ES", "en")
+
+	tests := []struct {
+		locale string
+		want   []string
+	}{
+		{locale: "pt-br", want: []string{"pt-br", "pt", "en-us", "en"}},
+		{locale: "gl", want: []string{"gl", "es-es", "es", "en-us", "en"}},
+		{locale: "en", want: []string{"en-us", "en"}},
+		{locale: "", want: []string{"en-us", "en"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.locale, func(t *testing.T) {
+			got := localeChain(test.locale)
+
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTranslatedErrMsg(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	resetMessageCatalogs(t)
+
+	RegisterMessageCatalog("en", MessageCatalog{
+		"Length":       "{field} must have at least {min} characters",
+		"invalid_body": "the body of the request is invalid",
+		"default":      "{field} is invalid",
+	})
+	RegisterMessageCatalog("pt", MessageCatalog{
+		"Length":  "{field} deve ter pelo menos {min} caracteres",
+		"default": "{field} é inválido",
+	})
+	RegisterMessageCatalog("pt-BR", MessageCatalog{
+		"Numeric": "{field} deve ser numérico, recebeu {value}",
+	})
+	SetDefaultLocale("en")
+
+	chainFmt := func(initialValue, sanitizedValue, validatorName string) string {
+		return "chain: " + validatorName
+	}
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		resolver       LocaleResolverFunc
+		body           string
+		chain          ValidationChain
+
+		want []string
+	}{
+		{
+			name:           "Negotiates the locale from Accept-Language.",
+			acceptLanguage: "de, pt-BR;q=0.9, en;q=0.8",
+			body:           `{"name": "ab", "age": "x"}`,
+			chain:          NewBodyChain("name", nil).Length(&vgo.IsLengthOpts{Min: 5}).Numeric(nil),
+			want:           []string{"name deve ter pelo menos 5 caracteres", "name deve ser numérico, recebeu ab"},
+		},
+		{
+			name:           "Falls back to the default locale.",
+			acceptLanguage: "de",
+			body:           `{"name": "ab"}`,
+			chain:          NewBodyChain("name", nil).Length(&vgo.IsLengthOpts{Min: 5}).Numeric(nil),
+			want:           []string{"name must have at least 5 characters", "name is invalid"},
+		},
+		{
+			name:           "Uses the resolver over Accept-Language.",
+			acceptLanguage: "en",
+			resolver: func(ctx *gin.Context) string {
+				return ctx.Query("lang")
+			},
+			body:  `{"name": "ab"}`,
+			chain: NewBodyChain("name", nil).Length(&vgo.IsLengthOpts{Min: 5}),
+			want:  []string{"name deve ter pelo menos 5 caracteres"},
+		},
+		{
+			name:           "ErrFmtFunc takes precedence.",
+			acceptLanguage: "pt",
+			body:           `{"name": "ab"}`,
+			chain:          NewBodyChain("name", chainFmt).Length(&vgo.IsLengthOpts{Min: 5}),
+			want:           []string{"chain: Length"},
+		},
+		{
+			name:           "WithMessage takes precedence.",
+			acceptLanguage: "pt",
+			body:           `{"name": "ab"}`,
+			chain:          NewBodyChain("name", nil).Length(&vgo.IsLengthOpts{Min: 5}).WithMessage("too short"),
+			want:           []string{"too short"},
+		},
+		{
+			name:           "Translates extraction errors by code.",
+			acceptLanguage: "en",
+			body:           `{"name":`,
+			chain:          NewBodyChain("name", nil).Length(&vgo.IsLengthOpts{Min: 5}),
+			want:           []string{"the body of the request is invalid"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			SetLocaleResolver(test.resolver)
+			t.Cleanup(func() { SetLocaleResolver(nil) })
+
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var errs []ValidationChainError
+
+			router.POST("/test", test.chain.Validate(), func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, "/test?lang=pt-BR", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept-Language", test.acceptLanguage)
+			router.ServeHTTP(w, req)
+
+			got := make([]string, len(errs))
+			for i, err := range errs {
+				got[i] = err.Message
+			}
+
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("got messages %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLoadMessageCatalogs(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/en.json":   {Data: []byte(`{"Email": "{field} must be an email", "Length": {"too_short": "{field} is too short"}}`)},
+		"locales/pt-BR.yml": {Data: []byte("Email: \"{field} deve ser um e-mail\"\nLength:\n  too_short: \"{field} é curto demais\"\n")},
+		"broken/fr.json":    {Data: []byte(`{"Email": 1}`)},
+		"broken/de.toml":    {Data: []byte(`Email = "x"`)},
+	}
+
+	t.Run("Loads and flattens JSON and YAML files.", func(t *testing.T) {
+		resetMessageCatalogs(t)
+
+		if err := LoadMessageCatalogs(fsys, "locales/*.json", "locales/*.yml"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := map[string]MessageCatalog{
+			"en":    {"Email": "{field} must be an email", "Length.too_short": "{field} is too short"},
+			"pt-br": {"Email": "{field} deve ser um e-mail", "Length.too_short": "{field} é curto demais"},
+		}
+
+		if !cmp.Equal(messageCatalogs, want) {
+			t.Errorf("got %v, want %v", messageCatalogs, want)
+		}
+	})
+
+	for _, pattern := range []string{"broken/*.json", "broken/*.toml", "missing/*.json"} {
+		t.Run(pattern, func(t *testing.T) {
+			resetMessageCatalogs(t)
+
+			if err := LoadMessageCatalogs(fsys, pattern); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
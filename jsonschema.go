@@ -0,0 +1,231 @@
+package ginvalidator
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+)
+
+// JSONSchemaValidatorName is the name of the validator of [NewJSONSchemaValidator], passed to ErrFmtFunc and used as a translation key.
+const JSONSchemaValidatorName string = "JSONSchema"
+
+// Codes of the errors reported by [NewJSONSchemaValidator], named after the JSON Schema keyword that failed.
+// A missing required property is reported with [RequiredCode].
+const (
+	// JSONSchemaTypeCode is reported when a value is not of the types of the type keyword.
+	JSONSchemaTypeCode string = "type"
+
+	// JSONSchemaEnumCode is reported when a value is none of the values of the enum keyword.
+	JSONSchemaEnumCode string = "enum"
+
+	// JSONSchemaConstCode is reported when a value is not the value of the const keyword.
+	JSONSchemaConstCode string = "const"
+
+	// JSONSchemaMultipleOfCode is reported when a number is not a multiple of the multipleOf keyword.
+	JSONSchemaMultipleOfCode string = "multiple_of"
+
+	// JSONSchemaMinimumCode is reported when a number is less than the minimum keyword.
+	JSONSchemaMinimumCode string = "minimum"
+
+	// JSONSchemaMaximumCode is reported when a number is greater than the maximum keyword.
+	JSONSchemaMaximumCode string = "maximum"
+
+	// JSONSchemaExclusiveMinimumCode is reported when a number is not greater than the exclusiveMinimum keyword.
+	JSONSchemaExclusiveMinimumCode string = "exclusive_minimum"
+
+	// JSONSchemaExclusiveMaximumCode is reported when a number is not less than the exclusiveMaximum keyword.
+	JSONSchemaExclusiveMaximumCode string = "exclusive_maximum"
+
+	// JSONSchemaMinLengthCode is reported when a string has fewer characters than the minLength keyword.
+	JSONSchemaMinLengthCode string = "min_length"
+
+	// JSONSchemaMaxLengthCode is reported when a string has more characters than the maxLength keyword.
+	JSONSchemaMaxLengthCode string = "max_length"
+
+	// JSONSchemaPatternCode is reported when a string does not match the pattern keyword.
+	JSONSchemaPatternCode string = "pattern"
+
+	// JSONSchemaFormatCode is reported when a string is not of the format of the format keyword.
+	JSONSchemaFormatCode string = "format"
+
+	// JSONSchemaMinItemsCode is reported when an array has fewer items than the minItems keyword.
+	JSONSchemaMinItemsCode string = "min_items"
+
+	// JSONSchemaMaxItemsCode is reported when an array has more items than the maxItems keyword.
+	JSONSchemaMaxItemsCode string = "max_items"
+
+	// JSONSchemaUniqueItemsCode is reported when an array has duplicate items and the uniqueItems keyword is true.
+	JSONSchemaUniqueItemsCode string = "unique_items"
+
+	// JSONSchemaItemsCode is reported for an item of an array the items keyword does not allow.
+	JSONSchemaItemsCode string = "items"
+
+	// JSONSchemaContainsCode is reported when fewer items than the minContains keyword, 1 by default, match the contains keyword.
+	JSONSchemaContainsCode string = "contains"
+
+	// JSONSchemaMaxContainsCode is reported when more items than the maxContains keyword match the contains keyword.
+	JSONSchemaMaxContainsCode string = "max_contains"
+
+	// JSONSchemaMinPropertiesCode is reported when an object has fewer properties than the minProperties keyword.
+	JSONSchemaMinPropertiesCode string = "min_properties"
+
+	// JSONSchemaMaxPropertiesCode is reported when an object has more properties than the maxProperties keyword.
+	JSONSchemaMaxPropertiesCode string = "max_properties"
+
+	// JSONSchemaDependentRequiredCode is reported for a property the dependentRequired keyword requires that is missing.
+	JSONSchemaDependentRequiredCode string = "dependent_required"
+
+	// JSONSchemaAdditionalPropertiesCode is reported for a property the additionalProperties keyword does not allow.
+	JSONSchemaAdditionalPropertiesCode string = "additional_properties"
+
+	// JSONSchemaPropertyNamesCode is reported for a property whose name does not match the propertyNames keyword.
+	JSONSchemaPropertyNamesCode string = "property_names"
+
+	// JSONSchemaUnevaluatedPropertiesCode is reported for a property the unevaluatedProperties keyword does not allow.
+	JSONSchemaUnevaluatedPropertiesCode string = "unevaluated_properties"
+
+	// JSONSchemaUnevaluatedItemsCode is reported for an item of an array the unevaluatedItems keyword does not allow.
+	JSONSchemaUnevaluatedItemsCode string = "unevaluated_items"
+
+	// JSONSchemaAnyOfCode is reported when a value matches none of the schemas of the anyOf keyword.
+	JSONSchemaAnyOfCode string = "any_of"
+
+	// JSONSchemaOneOfCode is reported when a value matches none, or several, of the schemas of the oneOf keyword.
+	JSONSchemaOneOfCode string = "one_of"
+
+	// JSONSchemaNotCode is reported when a value matches the schema of the not keyword.
+	JSONSchemaNotCode string = "not"
+
+	// JSONSchemaFalseCode is reported for a value validated against the false schema.
+	JSONSchemaFalseCode string = "false_schema"
+
+	// JSONSchemaRefCycleCode is reported for a value whose validation follows references in an infinite cycle.
+	JSONSchemaRefCycleCode string = "ref_cycle"
+)
+
+// NewJSONSchemaValidator returns a middleware validating the JSON body of the request against
+// a JSON Schema document, e.g. a contract shared with other services.
+//
+// The schema is compiled once, so an invalid schema or a $ref that cannot be resolved is returned as an error
+// rather than failing requests. Schemas are evaluated by github.com/santhosh-tekuri/jsonschema, which passes
+// the official JSON Schema test suite: a schema without $schema is draft 2020-12, and the earlier drafts
+// it declares are supported too. References are resolved within the document only, including $dynamicRef.
+// The format keyword is asserted for every format the specification defines, and unknown formats are ignored.
+// Patterns use the ECMA-262 syntax of the specification, and a value taking a pattern more than 100ms to match does not match it.
+//
+// Each violation is saved to the result of the request as a [ValidationChainError] in the body location,
+// whose Field is the path of the invalid value in the field syntax of the chains (e.g. "items[2].sku"),
+// whose Code is one of the JSONSchema codes (e.g. [JSONSchemaMinLengthCode]) or [RequiredCode],
+// and whose Message can be translated under [JSONSchemaValidatorName] and the code,
+// with the value of the keyword as a placeholder, e.g. {minLength}.
+// A missing required property is reported on its own path, with an empty value.
+//
+// The body is decoded like it is for the chains, so XML bodies are validated as their JSON conversion,
+// and an empty body as null. A form body, or a body that cannot be decoded, is reported as a single error.
+//
+// Example:
+//
+//	schema, err := os.ReadFile("schemas/user.json")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//
+//	validateUser, err := ginvalidator.NewJSONSchemaValidator(schema)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//
+//	router.POST("/users", validateUser, handler)
+func NewJSONSchemaValidator(schemaBytes []byte) (gin.HandlerFunc, error) {
+	schema, err := compileJSONSchema(schemaBytes)
+	if err != nil {
+		return nil, fmt.Errorf("compile JSON schema: %w", err)
+	}
+
+	return func(ctx *gin.Context) {
+		saveValidationErrorsToCtx(ctx, schema.validateBody(ctx))
+		ctx.Next()
+	}, nil
+}
+
+// validateBody validates the body of the request against the schema.
+func (s *jsonSchema) validateBody(ctx *gin.Context) []ValidationChainError {
+	location := BodyLocation.String()
+
+	body, err := getRequestBody(ctx)
+	if err == nil && body.isForm {
+		err = fmt.Errorf("%s is %w", body.contentType, ErrExtractionInvalidContentType)
+	}
+
+	var violations []jsonSchemaViolation
+	if err == nil {
+		document := body.json
+		if !document.Exists() {
+			document = gjson.Parse("null")
+		}
+
+		violations, err = s.evaluate(document)
+	}
+
+	if err != nil {
+		code := extractionErrCode(err)
+
+		errMsg, ok := translateErrMsg(ctx, messageData{location: location, code: code})
+		if !ok {
+			errMsg = err.Error()
+		}
+
+		return []ValidationChainError{newValidationChainError(
+			vceWithLocation(location),
+			vceWithMessage(errMsg),
+			vceWithCode(code),
+		)}
+	}
+
+	errs := make([]ValidationChainError, len(violations))
+	for i, violation := range violations {
+		errs[i] = violation.chainError(ctx)
+	}
+
+	return errs
+}
+
+// jsonSchemaViolation is a value failing a keyword of a schema.
+type jsonSchemaViolation struct {
+	field  string         // the path of the value, in the field syntax of the chains
+	value  gjson.Result   // the value, which does not exist for a missing property
+	code   string         // the code of the keyword
+	params map[string]any // the value of the keyword, as message placeholders
+	msg    string         // the message reported when no translation is found
+
+	position []int // the position of the value in the document, to report the violations in its order
+}
+
+// chainError returns the error reported for the violation.
+func (v jsonSchemaViolation) chainError(ctx *gin.Context) ValidationChainError {
+	location := BodyLocation.String()
+	value := reportedFieldValue(v.field, v.value.String())
+
+	msg := v.msg
+	if DefaultErrFmtFunc != nil {
+		msg = DefaultErrFmtFunc(v.value.String(), v.value.String(), JSONSchemaValidatorName)
+	} else if translated, ok := translateErrMsg(ctx, messageData{
+		location:  location,
+		field:     v.field,
+		value:     value,
+		validator: JSONSchemaValidatorName,
+		code:      v.code,
+		params:    v.params,
+	}); ok {
+		msg = translated
+	}
+
+	return newValidationChainError(
+		vceWithLocation(location),
+		vceWithMessage(msg),
+		vceWithField(v.field),
+		vceWithValue(value),
+		vceWithCode(v.code),
+	)
+}
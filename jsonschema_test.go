@@ -0,0 +1,262 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tidwall/gjson"
+)
+
+func TestNewJSONSchemaValidator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	schema := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["email", "items"],
+		"properties": {
+			"email": {"type": "string", "format": "email"},
+			"name": {"type": "string", "minLength": 2},
+			"items": {"type": "array", "minItems": 1, "items": {"$ref": "#/$defs/item"}}
+		},
+		"additionalProperties": false,
+		"$defs": {
+			"item": {
+				"type": "object",
+				"required": ["sku"],
+				"properties": {
+					"sku": {"type": "string", "pattern": "^[A-Z]{3}-\\d+$"},
+					"qty": {"type": "integer", "minimum": 1}
+				}
+			}
+		}
+	}`
+
+	validate, err := NewJSONSchemaValidator([]byte(schema))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		contentType string
+		errs        []ValidationChainError
+	}{
+		{
+			name: "Passes a valid body.",
+			body: `{"email": "ada@example.com", "items": [{"sku": "ABC-1", "qty": 2}]}`,
+			errs: []ValidationChainError{},
+		},
+		{
+			name: "Reports every violation at the path of its value.",
+			body: `{"email": "ada", "name": "A", "items": [{"sku": "ABC-1", "qty": 1.0}, {"qty": 0.5}], "admin": true}`,
+			errs: []ValidationChainError{
+				{Location: "body", Field: "email", Value: "ada", Code: JSONSchemaFormatCode, Message: "must be a valid email"},
+				{Location: "body", Field: "name", Value: "A", Code: JSONSchemaMinLengthCode, Message: "must be at least 2 characters long"},
+				{Location: "body", Field: "items[1].sku", Code: RequiredCode, Message: "is required"},
+				{Location: "body", Field: "items[1].qty", Value: "0.5", Code: JSONSchemaTypeCode, Message: "expected integer, got number"},
+				{Location: "body", Field: "admin", Value: "true", Code: JSONSchemaAdditionalPropertiesCode, Message: "is not allowed"},
+			},
+		},
+		{
+			name: "Validates an empty body as null.",
+			errs: []ValidationChainError{
+				{Location: "body", Field: "", Value: "", Code: JSONSchemaTypeCode, Message: "expected object, got null"},
+			},
+		},
+		{
+			name:        "Reports a form body.",
+			body:        "email=ada@example.com",
+			contentType: "application/x-www-form-urlencoded",
+			errs: []ValidationChainError{
+				{Location: "body", Code: UnsupportedContentTypeCode, Message: "application/x-www-form-urlencoded is " + ErrExtractionInvalidContentType.Error()},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var errs []ValidationChainError
+
+			router := gin.New()
+			router.POST("/test", validate, func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+			})
+
+			contentType := test.contentType
+			if contentType == "" {
+				contentType = "application/json"
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", contentType)
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+		})
+	}
+}
+
+func TestNewJSONSchemaValidatorTranslation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	resetMessageCatalogs(t)
+
+	SetDefaultLocale("en")
+	RegisterMessageCatalog("en", MessageCatalog{
+		"JSONSchema.min_length": "{field} needs at least {minLength} characters",
+	})
+
+	validate, err := NewJSONSchemaValidator([]byte(`{"properties": {"name": {"minLength": 3}}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var errs []ValidationChainError
+
+	router := gin.New()
+	router.POST("/test", validate, func(ctx *gin.Context) {
+		errs, _ = ValidationResult(ctx)
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(`{"name": "Al"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	if want := "name needs at least 3 characters"; len(errs) != 1 || errs[0].Message != want {
+		t.Errorf("got errors %+v, want the message %q", errs, want)
+	}
+}
+
+func TestJSONSchemaKeywords(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		instance string
+		want     []string // the field and code of each violation
+	}{
+		{name: "integer accepts whole numbers", schema: `{"type": "integer"}`, instance: `1.0`},
+		{name: "type list", schema: `{"type": ["string", "null"]}`, instance: `1`, want: []string{":type"}},
+		{name: "multipleOf is exact", schema: `{"multipleOf": 0.01}`, instance: `19.99`},
+		{name: "multipleOf", schema: `{"multipleOf": 0.01}`, instance: `19.999`, want: []string{":multiple_of"}},
+		{name: "exclusive bounds", schema: `{"exclusiveMinimum": 0, "exclusiveMaximum": 10}`, instance: `10`, want: []string{":exclusive_maximum"}},
+		{name: "length counts characters", schema: `{"maxLength": 2}`, instance: `"éé"`},
+		{name: "enum compares numbers by value", schema: `{"enum": [1, "a", {"b": [null]}]}`, instance: `{"b": [null]}`},
+		{name: "enum", schema: `{"enum": [1, "a"]}`, instance: `"b"`, want: []string{":enum"}},
+		{name: "const", schema: `{"const": 1}`, instance: `1.0`},
+		{name: "uniqueItems", schema: `{"uniqueItems": true}`, instance: `[1, 1.0]`, want: []string{":unique_items"}},
+		{name: "prefixItems and items false", schema: `{"prefixItems": [{"type": "string"}], "items": false}`, instance: `["a", 2]`, want: []string{"[1]:items"}},
+		{
+			name:     "items after prefixItems at their index",
+			schema:   `{"prefixItems": [true, true], "items": {"properties": {"a": {"type": "string"}}}}`,
+			instance: `[0, 0, {"a": "x"}, {"a": 1}]`,
+			want:     []string{"[3].a:type"},
+		},
+		{name: "additionalItems of draft 7", schema: `{"$schema": "http://json-schema.org/draft-07/schema#", "items": [true], "additionalItems": {"type": "string"}}`, instance: `[1, "a", 2]`, want: []string{"[2]:type"}},
+		{name: "contains", schema: `{"contains": {"type": "string"}, "minContains": 2}`, instance: `["a", 1]`, want: []string{":contains"}},
+		{name: "maxContains", schema: `{"contains": {"type": "string"}, "maxContains": 1}`, instance: `["a", "b"]`, want: []string{":max_contains"}},
+		{name: "dependentRequired", schema: `{"dependentRequired": {"card": ["cvv"]}}`, instance: `{"card": "4242"}`, want: []string{"cvv:dependent_required"}},
+		{name: "propertyNames", schema: `{"propertyNames": {"pattern": "^[a-z]+$"}}`, instance: `{"ok": 1, "Bad": 2}`, want: []string{"Bad:property_names"}},
+		{name: "patternProperties", schema: `{"patternProperties": {"^x-": {"type": "string"}}, "additionalProperties": false}`, instance: `{"x-a": 1, "y": 2}`, want: []string{"x-a:type", "y:additional_properties"}},
+		{name: "escaped field", schema: `{"properties": {"a.b": {"type": "string"}}}`, instance: `{"a.b": 1}`, want: []string{`a\.b:type`}},
+		{name: "anyOf", schema: `{"anyOf": [{"type": "string"}, {"minimum": 5}]}`, instance: `1`, want: []string{":any_of"}},
+		{name: "oneOf", schema: `{"oneOf": [{"type": "integer"}, {"minimum": 0}]}`, instance: `1`, want: []string{":one_of"}},
+		{name: "not", schema: `{"not": {"type": "null"}}`, instance: `null`, want: []string{":not"}},
+		{name: "if then else", schema: `{"if": {"properties": {"kind": {"const": "a"}}}, "then": {"required": ["a"]}, "else": {"required": ["b"]}}`, instance: `{"kind": "a"}`, want: []string{"a:required"}},
+		{name: "format", schema: `{"format": "date"}`, instance: `"2024-02-30"`, want: []string{":format"}},
+		{name: "unknown format", schema: `{"format": "color"}`, instance: `"red"`},
+		{name: "every format of the specification", schema: `{"format": "duration"}`, instance: `"P1X"`, want: []string{":format"}},
+		{name: "ECMA-262 patterns", schema: `{"pattern": "^(?=.*\\d)[a-z\\d]+$"}`, instance: `"abc"`, want: []string{":pattern"}},
+		{name: "false schema", schema: `{"properties": {"a": false}}`, instance: `{"a": 1}`, want: []string{"a:false_schema"}},
+		{
+			name:     "unevaluatedProperties sees in-place applicators",
+			schema:   `{"allOf": [{"properties": {"a": true}}], "if": {"required": ["b"]}, "then": {"properties": {"b": true}}, "unevaluatedProperties": false}`,
+			instance: `{"a": 1, "b": 2, "c": 3}`,
+			want:     []string{"c:unevaluated_properties"},
+		},
+		{
+			name:     "unevaluatedItems",
+			schema:   `{"prefixItems": [true], "contains": {"type": "string"}, "unevaluatedItems": {"type": "boolean"}}`,
+			instance: `[1, "a", 2]`,
+			want:     []string{"[2]:type"},
+		},
+		{
+			name:     "$ref to an anchor of an embedded resource",
+			schema:   `{"$id": "https://example.com/root.json", "$ref": "item.json#code", "$defs": {"item": {"$id": "item.json", "$defs": {"code": {"$anchor": "code", "maxLength": 2}}}}}`,
+			instance: `"abc"`,
+			want:     []string{":max_length"},
+		},
+		{
+			name: "$dynamicRef resolves to the outermost dynamic anchor",
+			schema: `{"$id": "https://example.com/strings", "$ref": "list", "$defs": {
+				"items": {"$dynamicAnchor": "items", "type": "string"},
+				"list": {"$id": "list", "type": "array", "items": {"$dynamicRef": "#items"}, "$defs": {"items": {"$dynamicAnchor": "items"}}}
+			}}`,
+			instance: `["a", 1]`,
+			want:     []string{"[1]:type"},
+		},
+		{
+			name:     "recursive $ref",
+			schema:   `{"properties": {"children": {"items": {"$ref": "#"}}, "name": {"type": "string"}}}`,
+			instance: `{"children": [{"children": [{"name": 1}]}]}`,
+			want:     []string{"children[0].children[0].name:type"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema, err := compileJSONSchema([]byte(test.schema))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			violations, err := schema.evaluate(gjson.Parse(test.instance))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]string, 0)
+			for _, violation := range violations {
+				got = append(got, violation.field+":"+violation.code)
+			}
+
+			if !cmp.Equal(got, test.want, cmpopts.EquateEmpty()) {
+				t.Errorf("got violations %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNewJSONSchemaValidatorErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{name: "invalid JSON", schema: `{"type": `, err: "schema is not valid JSON"},
+		{name: "unknown dialect", schema: `{"$schema": "https://example.com/meta"}`, err: `"https://example.com/meta"`},
+		{name: "not a schema", schema: `{"properties": {"a": 1}}`, err: "at '/properties/a'"},
+		{name: "invalid keyword", schema: `{"minLength": -1}`, err: "at '/minLength'"},
+		{name: "unknown type", schema: `{"type": "float"}`, err: "at '/type'"},
+		{name: "invalid pattern", schema: `{"items": {"pattern": "("}}`, err: "at '/items/pattern'"},
+		{name: "unresolved reference", schema: `{"$ref": "#/$defs/missing"}`, err: `"urn:ginvalidator:schema#/$defs/missing" not found`},
+		{name: "external reference", schema: `{"$ref": "https://example.com/user.json"}`, err: `"https://example.com/user.json"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewJSONSchemaValidator([]byte(test.schema))
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, want it to contain %q", err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/dlclark/regexp2"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// jsonSchemaDefaultBaseURI is the URI of the schema document, which its references without $id are resolved against.
+const jsonSchemaDefaultBaseURI string = "urn:ginvalidator:schema"
+
+// jsonSchemaPatternTimeout bounds the time a pattern may take to match a value of a request,
+// since ECMA-262 patterns may backtrack. A value taking longer does not match.
+var jsonSchemaPatternTimeout = 100 * time.Millisecond
+
+// jsonSchema is a compiled JSON Schema document.
+type jsonSchema struct {
+	schema *jsonschema.Schema
+
+	// itemOffsets are the number of items before those of the subschemas at these locations apply to:
+	// the prefixItems before items, or the items before additionalItems. The library reports the items
+	// these subschemas validate at their index among the items the subschema applies to, e.g. 0 for the
+	// first item after the prefixItems, so the offsets put them back at their index in the array.
+	itemOffsets map[string]int
+}
+
+// compileJSONSchema compiles a JSON Schema document, draft 2020-12 unless its $schema says otherwise.
+func compileJSONSchema(schemaBytes []byte) (*jsonSchema, error) {
+	document, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaBytes))
+	if err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %w", err)
+	}
+
+	c := jsonschema.NewCompiler()
+	c.DefaultDraft(jsonschema.Draft2020)
+	c.AssertFormat()
+	c.UseRegexpEngine(compileECMARegexp)
+	// No scheme is loaded, so references are resolved within the document and the meta-schemas of the drafts only.
+	c.UseLoader(jsonschema.SchemeURLLoader{})
+
+	if err := c.AddResource(jsonSchemaDefaultBaseURI, document); err != nil {
+		return nil, err
+	}
+
+	schema, err := c.Compile(jsonSchemaDefaultBaseURI)
+	if err != nil {
+		return nil, err
+	}
+
+	itemOffsets := make(map[string]int)
+	indexJSONSchemaItemOffsets(schema, itemOffsets, make(map[*jsonschema.Schema]bool))
+
+	return &jsonSchema{schema: schema, itemOffsets: itemOffsets}, nil
+}
+
+// indexJSONSchemaItemOffsets records the item offsets of the schema and of its subschemas.
+func indexJSONSchemaItemOffsets(s *jsonschema.Schema, offsets map[string]int, seen map[*jsonschema.Schema]bool) {
+	if s == nil || seen[s] {
+		return
+	}
+	seen[s] = true
+
+	if s.Items2020 != nil && len(s.PrefixItems) > 0 {
+		offsets[s.Items2020.Location] = len(s.PrefixItems)
+	}
+	if items, ok := s.Items.([]*jsonschema.Schema); ok {
+		if additional, ok := s.AdditionalItems.(*jsonschema.Schema); ok && len(items) > 0 {
+			offsets[additional.Location] = len(items)
+		}
+	}
+
+	subschemas := []*jsonschema.Schema{
+		s.Ref, s.RecursiveRef, s.Not, s.If, s.Then, s.Else, s.PropertyNames, s.UnevaluatedProperties,
+		s.Contains, s.Items2020, s.UnevaluatedItems, s.ContentSchema,
+	}
+	subschemas = append(subschemas, s.AllOf...)
+	subschemas = append(subschemas, s.AnyOf...)
+	subschemas = append(subschemas, s.OneOf...)
+	subschemas = append(subschemas, s.PrefixItems...)
+
+	if s.DynamicRef != nil {
+		subschemas = append(subschemas, s.DynamicRef.Ref)
+	}
+	for _, sub := range s.Properties {
+		subschemas = append(subschemas, sub)
+	}
+	for _, sub := range s.PatternProperties {
+		subschemas = append(subschemas, sub)
+	}
+	for _, sub := range s.DependentSchemas {
+		subschemas = append(subschemas, sub)
+	}
+	for _, dependency := range s.Dependencies {
+		if sub, ok := dependency.(*jsonschema.Schema); ok {
+			subschemas = append(subschemas, sub)
+		}
+	}
+	for _, applicator := range []any{s.AdditionalProperties, s.Items, s.AdditionalItems} {
+		switch sub := applicator.(type) {
+		case *jsonschema.Schema:
+			subschemas = append(subschemas, sub)
+		case []*jsonschema.Schema:
+			subschemas = append(subschemas, sub...)
+		}
+	}
+
+	for _, sub := range subschemas {
+		indexJSONSchemaItemOffsets(sub, offsets, seen)
+	}
+}
+
+// ecmaRegexp is a pattern of a schema, matched with the ECMA-262 syntax JSON Schema specifies.
+type ecmaRegexp regexp2.Regexp
+
+// MatchString reports whether the string matches the pattern. A match that fails or times out is no match.
+func (re *ecmaRegexp) MatchString(s string) bool {
+	matched, err := (*regexp2.Regexp)(re).MatchString(s)
+	return err == nil && matched
+}
+
+// String returns the pattern.
+func (re *ecmaRegexp) String() string {
+	return (*regexp2.Regexp)(re).String()
+}
+
+// compileECMARegexp compiles a pattern of a schema with the ECMA-262 syntax.
+func compileECMARegexp(pattern string) (jsonschema.Regexp, error) {
+	re, err := regexp2.Compile(pattern, regexp2.ECMAScript)
+	if err != nil {
+		return nil, err
+	}
+
+	re.MatchTimeout = jsonSchemaPatternTimeout
+	return (*ecmaRegexp)(re), nil
+}
@@ -0,0 +1,350 @@
+package ginvalidator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"github.com/tidwall/gjson"
+)
+
+// evaluate validates a JSON document against the schema and returns its violations, in the order of the document.
+func (s *jsonSchema) evaluate(document gjson.Result) ([]jsonSchemaViolation, error) {
+	instance, err := jsonschema.UnmarshalJSON(strings.NewReader(document.Raw))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrExtractionInvalidJSON, err)
+	}
+
+	var verr *jsonschema.ValidationError
+	if err := s.schema.Validate(instance); !errors.As(err, &verr) {
+		return nil, err
+	}
+
+	c := jsonSchemaViolations{document: document, itemOffsets: s.itemOffsets}
+	c.add(verr, nil)
+
+	// The library visits the properties of an object in no particular order.
+	// A value comes before the values within it.
+	slices.SortStableFunc(c.violations, func(a, b jsonSchemaViolation) int {
+		return slices.Compare(a.position, b.position)
+	})
+
+	return c.violations, nil
+}
+
+// jsonSchemaViolations collects the violations of a document from the validation errors of the library.
+type jsonSchemaViolations struct {
+	document    gjson.Result
+	itemOffsets map[string]int
+	violations  []jsonSchemaViolation
+}
+
+// jsonSchemaItemFix is an item offset to add to the index at a depth of the locations the library reports.
+type jsonSchemaItemFix struct {
+	depth  int
+	offset int
+}
+
+// add collects the violations of a validation error, reporting the errors of the keywords that apply subschemas
+// to the value itself, e.g. anyOf, as a single violation rather than as the errors of their subschemas.
+func (c *jsonSchemaViolations) add(verr *jsonschema.ValidationError, fixes []jsonSchemaItemFix) {
+	fixes = c.itemFixes(verr, fixes)
+
+	location := slices.Clone(verr.InstanceLocation)
+	for _, fix := range fixes {
+		if index, err := strconv.Atoi(location[fix.depth]); err == nil {
+			location[fix.depth] = strconv.Itoa(index + fix.offset)
+		}
+	}
+
+	switch k := verr.ErrorKind.(type) {
+	case *kind.Type:
+		c.fail(location, JSONSchemaTypeCode, map[string]any{"type": strings.Join(k.Want, ", ")},
+			fmt.Sprintf("expected %s, got %s", strings.Join(k.Want, " or "), k.Got))
+	case *kind.Enum:
+		raws := make([]string, len(k.Want))
+		for i, allowed := range k.Want {
+			raws[i] = jsonSchemaRaw(allowed)
+		}
+
+		c.fail(location, JSONSchemaEnumCode, map[string]any{"enum": strings.Join(raws, ", ")},
+			fmt.Sprintf("must be one of %s", strings.Join(raws, ", ")))
+	case *kind.Const:
+		c.fail(location, JSONSchemaConstCode, map[string]any{"const": jsonSchemaRaw(k.Want)},
+			fmt.Sprintf("must be %s", jsonSchemaRaw(k.Want)))
+	case *kind.MultipleOf:
+		c.fail(location, JSONSchemaMultipleOfCode, map[string]any{"multipleOf": jsonSchemaRat(k.Want)},
+			fmt.Sprintf("must be a multiple of %s", jsonSchemaRat(k.Want)))
+	case *kind.Minimum:
+		c.fail(location, JSONSchemaMinimumCode, map[string]any{"minimum": jsonSchemaRat(k.Want)},
+			fmt.Sprintf("must be greater than or equal to %s", jsonSchemaRat(k.Want)))
+	case *kind.Maximum:
+		c.fail(location, JSONSchemaMaximumCode, map[string]any{"maximum": jsonSchemaRat(k.Want)},
+			fmt.Sprintf("must be less than or equal to %s", jsonSchemaRat(k.Want)))
+	case *kind.ExclusiveMinimum:
+		c.fail(location, JSONSchemaExclusiveMinimumCode, map[string]any{"exclusiveMinimum": jsonSchemaRat(k.Want)},
+			fmt.Sprintf("must be greater than %s", jsonSchemaRat(k.Want)))
+	case *kind.ExclusiveMaximum:
+		c.fail(location, JSONSchemaExclusiveMaximumCode, map[string]any{"exclusiveMaximum": jsonSchemaRat(k.Want)},
+			fmt.Sprintf("must be less than %s", jsonSchemaRat(k.Want)))
+	case *kind.MinLength:
+		c.fail(location, JSONSchemaMinLengthCode, map[string]any{"minLength": k.Want},
+			fmt.Sprintf("must be at least %d characters long", k.Want))
+	case *kind.MaxLength:
+		c.fail(location, JSONSchemaMaxLengthCode, map[string]any{"maxLength": k.Want},
+			fmt.Sprintf("must be at most %d characters long", k.Want))
+	case *kind.Pattern:
+		c.fail(location, JSONSchemaPatternCode, map[string]any{"pattern": k.Want},
+			fmt.Sprintf("must match the pattern %q", k.Want))
+	case *kind.Format:
+		c.fail(location, JSONSchemaFormatCode, map[string]any{"format": k.Want},
+			fmt.Sprintf("must be a valid %s", k.Want))
+	case *kind.MinItems:
+		c.fail(location, JSONSchemaMinItemsCode, map[string]any{"minItems": k.Want},
+			fmt.Sprintf("must have at least %d items", k.Want))
+	case *kind.MaxItems:
+		c.fail(location, JSONSchemaMaxItemsCode, map[string]any{"maxItems": k.Want},
+			fmt.Sprintf("must have at most %d items", k.Want))
+	case *kind.UniqueItems:
+		c.fail(location, JSONSchemaUniqueItemsCode, map[string]any{"uniqueItems": true},
+			fmt.Sprintf("must have unique items, items %d and %d are equal", k.Duplicates[0], k.Duplicates[1]))
+	case *kind.AdditionalItems:
+		// The items of draft 2019-09 and earlier that neither items nor additionalItems allow are the last ones.
+		items := c.value(location).Array()
+		for i := len(items) - k.Count; i < len(items); i++ {
+			c.fail(append(slices.Clip(location), strconv.Itoa(i)), JSONSchemaItemsCode, nil, "is not allowed")
+		}
+	case *kind.Contains:
+		c.fail(location, JSONSchemaContainsCode, map[string]any{"minContains": 1}, "must contain at least 1 matching items")
+	case *kind.MinContains:
+		c.fail(location, JSONSchemaContainsCode, map[string]any{"minContains": k.Want},
+			fmt.Sprintf("must contain at least %d matching items", k.Want))
+	case *kind.MaxContains:
+		c.fail(location, JSONSchemaMaxContainsCode, map[string]any{"maxContains": k.Want},
+			fmt.Sprintf("must contain at most %d matching items", k.Want))
+	case *kind.MinProperties:
+		c.fail(location, JSONSchemaMinPropertiesCode, map[string]any{"minProperties": k.Want},
+			fmt.Sprintf("must have at least %d properties", k.Want))
+	case *kind.MaxProperties:
+		c.fail(location, JSONSchemaMaxPropertiesCode, map[string]any{"maxProperties": k.Want},
+			fmt.Sprintf("must have at most %d properties", k.Want))
+	case *kind.Required:
+		for _, name := range k.Missing {
+			c.fail(append(slices.Clip(location), name), RequiredCode, map[string]any{"property": name}, "is required")
+		}
+	case *kind.DependentRequired:
+		c.failDependency(location, k.Prop, k.Missing)
+	case *kind.Dependency:
+		// The dependencies keyword of draft 7 and earlier requires properties, or applies a schema reported by the causes.
+		c.failDependency(location, k.Prop, k.Missing)
+		c.addCauses(verr, fixes)
+	case *kind.AdditionalProperties:
+		for _, name := range k.Properties {
+			c.fail(append(slices.Clip(location), name), JSONSchemaAdditionalPropertiesCode, nil, "is not allowed")
+		}
+	case *kind.PropertyNames:
+		c.violations = append(c.violations, c.violation(append(slices.Clip(location), k.Property), gjson.Result{Type: gjson.String, Str: k.Property},
+			JSONSchemaPropertyNamesCode, map[string]any{"property": k.Property}, fmt.Sprintf("%q is not an allowed property name", k.Property)))
+	case *kind.AnyOf:
+		c.fail(location, JSONSchemaAnyOfCode, nil, "must match at least one of the schemas of anyOf")
+	case *kind.OneOf:
+		c.fail(location, JSONSchemaOneOfCode, map[string]any{"matches": len(k.Subschemas)},
+			fmt.Sprintf("must match exactly one of the schemas of oneOf, matches %d", len(k.Subschemas)))
+	case *kind.Not:
+		c.fail(location, JSONSchemaNotCode, nil, "must not match the schema of not")
+	case *kind.FalseSchema:
+		if code := jsonSchemaFalseKeywordCode(verr.SchemaURL); code != "" {
+			c.fail(location, code, nil, "is not allowed")
+		} else {
+			c.fail(location, JSONSchemaFalseCode, nil, "no value is allowed")
+		}
+	case *kind.RefCycle:
+		c.fail(location, JSONSchemaRefCycleCode, map[string]any{"ref": k.URL},
+			fmt.Sprintf("cannot be validated, the reference %s recurses infinitely", k.URL))
+	default:
+		// The schema itself, allOf, references and groups of errors are reported by their causes.
+		c.addCauses(verr, fixes)
+	}
+}
+
+// addCauses collects the violations of the causes of a validation error.
+func (c *jsonSchemaViolations) addCauses(verr *jsonschema.ValidationError, fixes []jsonSchemaItemFix) {
+	for _, cause := range verr.Causes {
+		c.add(cause, fixes)
+	}
+}
+
+// itemFixes returns the item offsets to apply to the location of a validation error and of its causes:
+// those of the errors it is a cause of, and the offset of the subschema it was reported within, if any.
+func (c *jsonSchemaViolations) itemFixes(verr *jsonschema.ValidationError, fixes []jsonSchemaItemFix) []jsonSchemaItemFix {
+	for location, offset := range c.itemOffsets {
+		if verr.SchemaURL != location && !strings.HasPrefix(verr.SchemaURL, location+"/") {
+			continue
+		}
+
+		// The item is the value the subschema applies to, above the values its keywords went into.
+		depth := len(verr.InstanceLocation) - 1 - jsonSchemaInstanceDepth(verr.SchemaURL[len(location):])
+		if depth < 0 || slices.ContainsFunc(fixes, func(fix jsonSchemaItemFix) bool { return fix.depth == depth }) {
+			continue
+		}
+
+		fixes = append(slices.Clip(fixes), jsonSchemaItemFix{depth: depth, offset: offset})
+	}
+
+	return fixes
+}
+
+// jsonSchemaInstanceDepth returns how many levels into a value the keywords of a JSON pointer within a schema go,
+// e.g. 2 for "/properties/a/allOf/0/items".
+func jsonSchemaInstanceDepth(pointer string) int {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	depth := 0
+
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case "properties", "patternProperties", "prefixItems":
+			depth++
+			i++ // the name, pattern or index
+		case "items":
+			depth++
+			if i+1 < len(tokens) {
+				if _, err := strconv.Atoi(tokens[i+1]); err == nil {
+					i++ // the index of the items of draft 2019-09 and earlier
+				}
+			}
+		case "additionalProperties", "unevaluatedProperties", "propertyNames", "additionalItems", "unevaluatedItems", "contains":
+			depth++
+		case "allOf", "anyOf", "oneOf", "dependentSchemas", "dependencies", "$defs", "definitions":
+			i++ // the index or name
+		}
+	}
+
+	return depth
+}
+
+// failDependency records the properties a present property requires that are missing.
+func (c *jsonSchemaViolations) failDependency(location []string, property string, missing []string) {
+	for _, name := range missing {
+		c.fail(append(slices.Clip(location), name), JSONSchemaDependentRequiredCode,
+			map[string]any{"property": name, "dependentRequired": property},
+			fmt.Sprintf("is required when %s is present", property))
+	}
+}
+
+// fail records a violation of the value at the location of the document.
+func (c *jsonSchemaViolations) fail(location []string, code string, params map[string]any, msg string) {
+	c.violations = append(c.violations, c.violation(location, c.value(location), code, params, msg))
+}
+
+// violation returns the violation of a value at the location of the document.
+func (c *jsonSchemaViolations) violation(location []string, value gjson.Result, code string, params map[string]any, msg string) jsonSchemaViolation {
+	var field string
+	position := make([]int, len(location))
+
+	current := c.document
+	for i, token := range location {
+		if current.IsArray() {
+			field = joinConcretePath(field, "["+token+"]")
+			position[i], _ = strconv.Atoi(token)
+		} else {
+			field = joinConcretePath(field, escapeFieldPathKey(token))
+			// A missing property, e.g. a required one, comes before the properties of the object.
+			position[i] = -1
+			index := 0
+			current.ForEach(func(key, _ gjson.Result) bool {
+				if key.Str == token {
+					position[i] = index
+					return false
+				}
+				index++
+				return true
+			})
+		}
+		current = jsonSchemaChild(current, token)
+	}
+
+	return jsonSchemaViolation{field: field, value: value, code: code, params: params, msg: msg, position: position}
+}
+
+// value returns the value at the location of the document, which does not exist for a missing property.
+func (c *jsonSchemaViolations) value(location []string) gjson.Result {
+	current := c.document
+	for _, token := range location {
+		current = jsonSchemaChild(current, token)
+	}
+	return current
+}
+
+// jsonSchemaChild returns the item of an array at the index, or the property of an object with the name.
+func jsonSchemaChild(value gjson.Result, token string) gjson.Result {
+	if value.IsArray() {
+		if i, err := strconv.Atoi(token); err == nil && i >= 0 && i < len(value.Array()) {
+			return value.Array()[i]
+		}
+		return gjson.Result{}
+	}
+
+	var child gjson.Result
+	value.ForEach(func(key, value gjson.Result) bool {
+		if key.Str == token {
+			child = value
+			return false
+		}
+		return true
+	})
+	return child
+}
+
+// jsonSchemaFalseKeywordCode returns the code of the keyword whose subschema is the false schema at the URL,
+// e.g. "additionalProperties": false, or "" when the code of the false schema should be reported.
+func jsonSchemaFalseKeywordCode(schemaURL string) string {
+	u, err := url.Parse(schemaURL)
+	if err != nil {
+		return ""
+	}
+
+	tokens := strings.Split(u.Fragment, "/")
+	keyword := tokens[len(tokens)-1]
+	if len(tokens) >= 2 && tokens[len(tokens)-2] == "prefixItems" {
+		keyword = "prefixItems"
+	}
+
+	switch keyword {
+	case "items", "prefixItems", "additionalItems":
+		return JSONSchemaItemsCode
+	case "additionalProperties":
+		return JSONSchemaAdditionalPropertiesCode
+	case "unevaluatedItems":
+		return JSONSchemaUnevaluatedItemsCode
+	case "unevaluatedProperties":
+		return JSONSchemaUnevaluatedPropertiesCode
+	default:
+		return ""
+	}
+}
+
+// jsonSchemaRaw returns the JSON encoding of a value of a schema.
+func jsonSchemaRaw(v any) string {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(raw)
+}
+
+// jsonSchemaRat returns a number of a schema as a decimal, e.g. "0.01".
+func jsonSchemaRat(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+
+	f, _ := r.Float64()
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
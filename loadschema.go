@@ -0,0 +1,226 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadSchema reads a [Schema] from a JSON or YAML document, so schemas can be shared with other teams and languages.
+//
+// The document maps each field to its location, whether it is optional, and the ordered list of its rules.
+// A rule is the name of a validator, sanitizer or modifier of the registry (see [RegisterSchemaRule]), matched
+// regardless of case, either on its own or with the fields of [SchemaRule]: the arguments of the rule besides its options,
+// the fields of its vgo options struct, and an error message.
+//
+//	email:
+//	  in: body
+//	  rules:
+//	    - Trim
+//	    - name: Email
+//	      options: {allowDisplayName: false}
+//	      message: must be a valid email
+//	    - name: Length
+//	      options: {min: 3, max: 64}
+//	role:
+//	  in: body
+//	  optional: true
+//	  rules:
+//	    - name: In
+//	      args: [[admin, member]]
+//
+// The location is written as in [RequestLocation.String] or in singular, and is the body by default.
+// Every rule is built when the document is loaded, so an unknown key, a rule that is not registered,
+// or a rule with invalid arguments or options fails LoadSchema rather than a request.
+// The document can only reference rules registered before it is loaded.
+//
+// Example:
+//
+//	f, err := os.Open("schemas/register.yaml")
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//	defer f.Close()
+//
+//	schema, err := ginvalidator.LoadSchema(f)
+//	if err != nil {
+//	  log.Fatal(err)
+//	}
+//
+//	router.POST("/register", ginvalidator.CheckSchema(schema), handler)
+func LoadSchema(r io.Reader) (Schema, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("load schema: %w", err)
+	}
+
+	var doc map[string]schemaFieldDoc
+
+	// YAML is a superset of JSON, but a JSON document indented with tabs is not valid YAML.
+	if json.Valid(raw) {
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(&doc)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(raw))
+		dec.KnownFields(true)
+		err = dec.Decode(&doc)
+	}
+
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("load schema: %w", err)
+	}
+
+	fields := make([]string, 0, len(doc))
+	for field := range doc {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	schema := make(Schema, len(doc))
+
+	for _, field := range fields {
+		sf, err := doc[field].schemaField(field)
+		if err != nil {
+			return nil, fmt.Errorf("load schema: field %q: %w", field, err)
+		}
+
+		schema[field] = sf
+	}
+
+	return schema, nil
+}
+
+// schemaFieldDoc is a field of a schema document.
+type schemaFieldDoc struct {
+	In       string          `json:"in" yaml:"in"`
+	Optional bool            `json:"optional" yaml:"optional"`
+	Rules    []schemaRuleDoc `json:"rules" yaml:"rules"`
+}
+
+// schemaField builds the SchemaField of the field, building its rules once to report any invalid rule.
+func (d schemaFieldDoc) schemaField(field string) (SchemaField, error) {
+	loc := BodyLocation
+	if d.In != "" {
+		var ok bool
+		if loc, ok = parseRequestLocation(d.In); !ok {
+			return SchemaField{}, fmt.Errorf("unknown request location %q", d.In)
+		}
+	}
+
+	rules := make([]SchemaRule, len(d.Rules))
+	builders := make([]SchemaRuleBuilder, len(d.Rules))
+
+	for i, rd := range d.Rules {
+		builder, ok := LookupSchemaRule(rd.Name)
+		if !ok {
+			return SchemaField{}, fmt.Errorf("unknown rule %q", rd.Name)
+		}
+
+		rules[i], builders[i] = SchemaRule(rd), builder
+	}
+
+	build := func(vc ValidationChain) (ValidationChain, error) {
+		for i, rule := range rules {
+			before := len(vc.validator.ruleDescriptors)
+
+			var err error
+			if vc, err = builders[i](vc, rule); err != nil {
+				return vc, fmt.Errorf("rule %s: %w", rule.Name, err)
+			}
+
+			if rule.Message == "" {
+				continue
+			}
+
+			// A message belongs to a validator of its rule; on a sanitizer or modifier, it would go to an earlier rule.
+			if !slices.ContainsFunc(vc.validator.ruleDescriptors[min(before, len(vc.validator.ruleDescriptors)):], func(d ruleDescriptor) bool { return d.chainType == validatorType }) {
+				return vc, fmt.Errorf("rule %s: message is set, but the rule adds no validator", rule.Name)
+			}
+			vc = vc.WithMessage(rule.Message)
+		}
+
+		return vc, nil
+	}
+
+	if _, err := build(newValidationChain(field, nil, loc)); err != nil {
+		return SchemaField{}, err
+	}
+
+	return SchemaField{
+		In:       loc,
+		Optional: d.Optional,
+		Build: func(vc ValidationChain) ValidationChain {
+			// The rules were built once by LoadSchema, so they cannot fail.
+			vc, _ = build(vc)
+			return vc
+		},
+	}, nil
+}
+
+// schemaRuleDoc is a rule of a schema document, written either as its name or as an object.
+type schemaRuleDoc struct {
+	Name    string         `json:"name" yaml:"name"`
+	Args    []any          `json:"args" yaml:"args"`
+	Options map[string]any `json:"options" yaml:"options"`
+	Message string         `json:"message" yaml:"message"`
+}
+
+// errSchemaRuleNoName is returned for a rule of a schema document without a name.
+var errSchemaRuleNoName = errors.New("rule has no name")
+
+// UnmarshalJSON decodes a rule written as its name or as an object.
+func (d *schemaRuleDoc) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		return json.Unmarshal(data, &d.Name)
+	}
+
+	type plain schemaRuleDoc
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode((*plain)(d)); err != nil {
+		return err
+	}
+
+	if d.Name == "" {
+		return errSchemaRuleNoName
+	}
+
+	return nil
+}
+
+// UnmarshalYAML decodes a rule written as its name or as a mapping.
+func (d *schemaRuleDoc) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		return node.Decode(&d.Name)
+	}
+
+	// Unlike the decoder of LoadSchema, Decode accepts unknown keys.
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i < len(node.Content); i += 2 {
+			switch key := node.Content[i].Value; key {
+			case "name", "args", "options", "message":
+			default:
+				return fmt.Errorf("line %d: unknown rule key %q", node.Content[i].Line, key)
+			}
+		}
+	}
+
+	type plain schemaRuleDoc
+	if err := node.Decode((*plain)(d)); err != nil {
+		return err
+	}
+
+	if d.Name == "" {
+		return errSchemaRuleNoName
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestLoadSchema(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	RegisterSchemaRule("NotAdmin", func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+		return vc.CustomValidator(func(r *http.Request, initialValue, sanitizedValue string) bool {
+			return sanitizedValue != "admin"
+		}), nil
+	})
+
+	yamlDoc := `
+email:
+  in: body
+  rules:
+    - trim
+    - name: Email
+      message: must be a valid email
+username:
+  rules:
+    - Trim
+    - name: Length
+      options: {min: 3, max: 8}
+    - notadmin
+role:
+  in: body
+  optional: true
+  rules:
+    - name: In
+      args: [[admin, member]]
+page:
+  in: query
+  optional: true
+  rules:
+    - name: Int
+      options: {min: 1}
+`
+
+	jsonDoc := `{
+	"email": {"in": "body", "rules": ["trim", {"name": "Email", "message": "must be a valid email"}]},
+	"username": {"rules": ["Trim", {"name": "Length", "options": {"min": 3, "max": 8}}, "notadmin"]},
+	"role": {"in": "body", "optional": true, "rules": [{"name": "In", "args": [["admin", "member"]]}]},
+	"page": {"in": "query", "optional": true, "rules": [{"name": "Int", "options": {"min": 1}}]}
+}`
+
+	tests := []struct {
+		name   string
+		body   string
+		errs   []ValidationChainError
+		target string
+	}{
+		{
+			name:   "Passes a valid request.",
+			body:   `{"email": " ada@example.com ", "username": " ada ", "role": "member"}`,
+			target: "/test?page=2",
+			errs:   []ValidationChainError{},
+		},
+		{
+			name:   "Reports every rule in order, with the messages of the document.",
+			body:   `{"email": "ada", "username": " admin ", "role": "owner"}`,
+			target: "/test",
+			errs: []ValidationChainError{
+				{Location: "body", Field: "email", Value: "ada", Message: "must be a valid email"},
+				{Location: "body", Field: "role", Value: "owner", Message: DefaultErrMsg},
+				{Location: "body", Field: "username", Value: " admin ", Message: DefaultErrMsg},
+			},
+		},
+	}
+
+	for docName, doc := range map[string]string{"yaml": yamlDoc, "json": jsonDoc} {
+		schema, err := LoadSchema(strings.NewReader(doc))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", docName, err)
+		}
+
+		for _, test := range tests {
+			t.Run(docName+"/"+test.name, func(t *testing.T) {
+				var errs []ValidationChainError
+
+				router := gin.New()
+				router.POST("/test", CheckSchema(schema), func(ctx *gin.Context) {
+					errs, _ = ValidationResult(ctx)
+				})
+
+				req, _ := http.NewRequest(http.MethodPost, test.target, bytes.NewBufferString(test.body))
+				req.Header.Set("Content-Type", "application/json")
+				router.ServeHTTP(httptest.NewRecorder(), req)
+
+				if !cmp.Equal(errs, test.errs, cmpopts.IgnoreFields(ValidationChainError{}, "Code"), cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+					t.Errorf("got errors %+v, want %+v", errs, test.errs)
+				}
+			})
+		}
+	}
+}
+
+func TestLoadSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		err  string
+	}{
+		{name: "unknown rule", doc: "email:\n  rules: [emial]", err: `field "email": unknown rule "emial"`},
+		{name: "unknown location", doc: `{"email": {"in": "bdy"}}`, err: `unknown request location "bdy"`},
+		{name: "unknown field key", doc: "email:\n  optinal: true", err: "field optinal not found"},
+		{name: "unknown rule key", doc: "email:\n  rules:\n    - name: Email\n      option: {}", err: `unknown rule key "option"`},
+		{name: "unknown JSON rule key", doc: `{"email": {"rules": [{"name": "Email", "option": {}}]}}`, err: `unknown field "option"`},
+		{name: "rule without name", doc: `{"email": {"rules": [{"args": [1]}]}}`, err: "rule has no name"},
+		{name: "unknown option", doc: "email:\n  rules:\n    - name: Length\n      options: {minimum: 3}", err: `rule Length: options: json: unknown field "minimum"`},
+		{name: "missing argument", doc: "email:\n  rules: [Contains]", err: "rule Contains: expected 1 arguments, got 0"},
+		{name: "extra argument", doc: "email:\n  rules:\n    - name: Email\n      args: [strict]", err: "rule Email: expected 0 arguments, got 1"},
+		{name: "invalid argument", doc: "email:\n  rules:\n    - name: DivisibleBy\n      args: [two]", err: "rule DivisibleBy: argument 1"},
+		{name: "invalid pattern", doc: "email:\n  rules:\n    - name: Matches\n      args: ['[']", err: "rule Matches: error parsing regexp"},
+		{name: "message of a sanitizer", doc: "email:\n  rules:\n    - Email\n    - name: trim\n      message: must be trimmed", err: "rule trim: message is set, but the rule adds no validator"},
+		{name: "message of a modifier", doc: "email:\n  rules:\n    - Email\n    - name: Bail\n      message: stop", err: "rule Bail: message is set, but the rule adds no validator"},
+		{name: "invalid document", doc: "email: [", err: "load schema:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := LoadSchema(strings.NewReader(test.doc))
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, want it to contain %q", err, test.err)
+			}
+		})
+	}
+
+	if schema, err := LoadSchema(strings.NewReader("")); err != nil || len(schema) != 0 {
+		t.Errorf("got %v, %v for an empty document, want an empty schema", schema, err)
+	}
+}
+
+func TestSchemaRuleBuilders(t *testing.T) {
+	tests := []struct {
+		rule SchemaRule
+		want []string
+	}{
+		{rule: SchemaRule{Name: "email"}, want: []string{EmailValidatorName}},
+		{rule: SchemaRule{Name: "In", Args: []any{[]any{"a", "b"}}}, want: []string{InValidatorName}},
+		{rule: SchemaRule{Name: "Contains", Args: []any{"@"}, Options: map[string]any{"ignoreCase": true}}, want: []string{ContainsValidatorName}},
+		{rule: SchemaRule{Name: "EqualsField", Args: []any{"body", "password"}}, want: []string{EqualsFieldValidatorName}},
+		{rule: SchemaRule{Name: "ItemCount", Options: map[string]any{"min": 1, "max": 3}}, want: []string{ItemCountValidatorName}},
+		{rule: SchemaRule{Name: "Optional"}, want: []string{OptionalModifierName}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.rule.Name, func(t *testing.T) {
+			builder, ok := LookupSchemaRule(test.rule.Name)
+			if !ok {
+				t.Fatalf("rule %q is not registered", test.rule.Name)
+			}
+
+			vc, err := builder(NewBodyChain("field", nil), test.rule)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := ruleNames(vc); !cmp.Equal(got, test.want) {
+				t.Errorf("got rules %q, want %q", got, test.want)
+			}
+		})
+	}
+}
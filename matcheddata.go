@@ -2,6 +2,10 @@ package ginvalidator
 
 import (
 	"errors"
+	"fmt"
+	"mime/multipart"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -12,10 +16,20 @@ var (
 
 	// ErrNoMatchedData is returned when no matched data is found in the context.
 	ErrNoMatchedData = errors.New("no matched data available in context")
+
+	// ErrMatchedDataFieldNotFound is returned by the typed accessors of MatchedData when a field was not matched.
+	ErrMatchedDataFieldNotFound = errors.New("field not found in matched data")
 )
 
 const GinValidatorCtxMatchedDataStoreName string = "__ginvalidator__matched__data__"
 
+// ginValidatorCtxTypedMatchedDataStoreName is the context key of the Go values of matched fields
+// whose last sanitizer converted them (e.g. ToInt), used by [BindMatchedData].
+const ginValidatorCtxTypedMatchedDataStoreName string = "__ginvalidator__typed__matched__data__"
+
+// matchedTimeLayouts are the layouts [MatchedData.Time] accepts, starting with the one ToDate produces.
+var matchedTimeLayouts = []string{toDateLayout, time.RFC3339Nano, time.DateOnly}
+
 // MatchedDataFieldValues is a map of fields and their values for a request location.
 type MatchedDataFieldValues map[string]string
 
@@ -27,6 +41,7 @@ type MatchedDataFieldValues map[string]string
 //   - "headers": Data from request headers.
 //   - "params": Data from URL parameters.
 //   - "queries": Data from URL query parameters.
+//   - "files": The filenames of uploaded files.
 type MatchedData map[string]MatchedDataFieldValues
 
 // Get retrieves a specific field's value from a given request location within MatchedData.
@@ -42,6 +57,194 @@ func (md MatchedData) Get(loc RequestLocation, field string) (string, bool) {
 	return value, ok
 }
 
+// Values retrieves every value of a field from a given request location within MatchedData.
+// A field sent more than once (e.g. "?tag=a&tag=b") is matched under indexed fields ("tag[0]", "tag[1]"),
+// which are returned in order; any other field has a single value.
+//
+// Parameters:
+//   - loc: The request location to search in (e.g., "body", "cookies", "headers", "params", "queries").
+//   - field: The name of the field to retrieve.
+//
+// Returns:
+//   - The values associated with the specified field at the given location.
+//   - A boolean indicating if the field exists (true if found, false if not).
+func (md MatchedData) Values(loc RequestLocation, field string) ([]string, bool) {
+	fields := md[loc.String()]
+
+	if _, ok := fields[indexedField(field, 0)]; !ok {
+		value, ok := fields[field]
+		if !ok {
+			return nil, false
+		}
+		return []string{value}, true
+	}
+
+	var values []string
+	for i := 0; ; i++ {
+		value, ok := fields[indexedField(field, i)]
+		if !ok {
+			return values, true
+		}
+		values = append(values, value)
+	}
+}
+
+// MatchedValues is the matched data of a request, as returned by [GetMatchedValues]: the string form of every field,
+// as [MatchedData], and the Go value of the fields whose last sanitizer converted them (e.g. ToInt).
+// Its typed accessors read the Go value of a field first, so they return the value the sanitizer produced
+// rather than a parse of its string form, which may have lost precision (e.g. ToFloat keeps 6 decimals).
+type MatchedValues struct {
+	MatchedData
+	typed typedMatchedData
+}
+
+// Int retrieves a field's value from a given request location as an int, e.g. after the ToInt sanitizer.
+// It returns [ErrMatchedDataFieldNotFound] if the field was not matched, or an error if the value is not an integer.
+func (mv MatchedValues) Int(loc RequestLocation, field string) (int, error) {
+	value, err := mv.lookup(loc, field)
+	if err != nil {
+		return 0, err
+	}
+
+	if num, ok := mv.typed[loc.String()][field].(int); ok {
+		return num, nil
+	}
+
+	num, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, matchedDataConversionErr(loc, field, err)
+	}
+
+	return num, nil
+}
+
+// Float retrieves a field's value from a given request location as a float64, e.g. after the ToFloat sanitizer.
+// It returns [ErrMatchedDataFieldNotFound] if the field was not matched, or an error if the value is not a number.
+func (mv MatchedValues) Float(loc RequestLocation, field string) (float64, error) {
+	value, err := mv.lookup(loc, field)
+	if err != nil {
+		return 0, err
+	}
+
+	if num, ok := mv.typed[loc.String()][field].(float64); ok {
+		return num, nil
+	}
+
+	num, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, matchedDataConversionErr(loc, field, err)
+	}
+
+	return num, nil
+}
+
+// Bool retrieves a field's value from a given request location as a bool, e.g. after the ToBoolean sanitizer.
+// It returns [ErrMatchedDataFieldNotFound] if the field was not matched, or an error if the value is not a boolean.
+func (mv MatchedValues) Bool(loc RequestLocation, field string) (bool, error) {
+	value, err := mv.lookup(loc, field)
+	if err != nil {
+		return false, err
+	}
+
+	if b, ok := mv.typed[loc.String()][field].(bool); ok {
+		return b, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, matchedDataConversionErr(loc, field, err)
+	}
+
+	return b, nil
+}
+
+// Time retrieves a field's value from a given request location as a time.Time, e.g. after the ToDate sanitizer.
+// Values formatted by ToDate ("2006-01-02 15:04:05"), RFC 3339 timestamps and dates ("2006-01-02") are accepted.
+// It returns [ErrMatchedDataFieldNotFound] if the field was not matched, or an error if the value is not a time.
+func (mv MatchedValues) Time(loc RequestLocation, field string) (time.Time, error) {
+	value, err := mv.lookup(loc, field)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if t, ok := mv.typed[loc.String()][field].(time.Time); ok {
+		return t, nil
+	}
+
+	t, err := parseMatchedTime(value)
+	if err != nil {
+		return time.Time{}, matchedDataConversionErr(loc, field, err)
+	}
+
+	return t, nil
+}
+
+// Files retrieves the files a file chain matched for a field, in the order they were uploaded,
+// named after their sanitized filenames (see SanitizeFilename).
+// It returns [ErrMatchedDataFieldNotFound] if no file was matched.
+func (mv MatchedValues) Files(field string) ([]*multipart.FileHeader, error) {
+	typed := mv.typed[FileLocation.String()]
+
+	var files []*multipart.FileHeader
+	for i := 0; ; i++ {
+		file, ok := typed[indexedField(field, i)].(*multipart.FileHeader)
+		if !ok {
+			break
+		}
+		files = append(files, file)
+	}
+
+	if file, ok := typed[field].(*multipart.FileHeader); ok && len(files) == 0 {
+		files = append(files, file)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%s %q: %w", FileLocation, field, ErrMatchedDataFieldNotFound)
+	}
+
+	return files, nil
+}
+
+// Strings retrieves every value of a field from a given request location, like [MatchedData.Values].
+// It returns [ErrMatchedDataFieldNotFound] if the field was not matched.
+func (md MatchedData) Strings(loc RequestLocation, field string) ([]string, error) {
+	values, ok := md.Values(loc, field)
+	if !ok {
+		return nil, fmt.Errorf("%s %q: %w", loc, field, ErrMatchedDataFieldNotFound)
+	}
+
+	return values, nil
+}
+
+// lookup retrieves a field's value, or an error wrapping ErrMatchedDataFieldNotFound.
+func (md MatchedData) lookup(loc RequestLocation, field string) (string, error) {
+	value, ok := md.Get(loc, field)
+	if !ok {
+		return "", fmt.Errorf("%s %q: %w", loc, field, ErrMatchedDataFieldNotFound)
+	}
+
+	return value, nil
+}
+
+// matchedDataConversionErr returns the error of a matched value that cannot be converted to the requested type.
+func matchedDataConversionErr(loc RequestLocation, field string, err error) error {
+	return fmt.Errorf("%s %q: %w", loc, field, err)
+}
+
+// parseMatchedTime parses a matched value with the first of matchedTimeLayouts that fits.
+func parseMatchedTime(value string) (time.Time, error) {
+	var err error
+
+	for _, layout := range matchedTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
 // Has reports whether a field exists at the given request location.
 func (md MatchedData) Has(loc RequestLocation, field string) bool {
 	_, ok := md[loc.String()][field]
@@ -56,7 +259,7 @@ func (md MatchedData) Has(loc RequestLocation, field string) bool {
 //   - ctx: The Gin context, which provides access to the HTTP request and response.
 //
 // Returns:
-//   - MatchedData: A map containing fields and their values organized by request location.
+//   - MatchedData: The fields and their values organized by request location.
 //   - error: An error if there was an issue extracting data from the context; otherwise, nil.
 func GetMatchedData(ctx *gin.Context) (MatchedData, error) {
 	if ctx == nil {
@@ -79,6 +282,18 @@ func GetMatchedData(ctx *gin.Context) (MatchedData, error) {
 	return store, nil
 }
 
+// GetMatchedValues returns the matched data of the request like [GetMatchedData], along with the Go values
+// of the fields converted by a sanitizer, which its typed accessors (Int, Float, Bool, Time) prefer,
+// and the files matched by file chains.
+func GetMatchedValues(ctx *gin.Context) (MatchedValues, error) {
+	md, err := GetMatchedData(ctx)
+	if err != nil {
+		return MatchedValues{}, err
+	}
+
+	return MatchedValues{MatchedData: md, typed: getTypedMatchedData(ctx)}, nil
+}
+
 // createMatchedDataStore initializes an empty MatchedData store and adds it to the context
 // under the key specified by GinValidatorCtxMatchedDataStoreName.
 func createMatchedDataStore(ctx *gin.Context) {
@@ -126,3 +341,40 @@ func saveMatchedDataToCtx(ctx *gin.Context, location, field, value string) {
 
 	ctx.Set(GinValidatorCtxMatchedDataStoreName, store)
 }
+
+// typedMatchedData holds the Go values of matched fields by request location and field.
+type typedMatchedData map[string]map[string]any
+
+// saveTypedMatchedDataToCtx saves the Go value of a matched field into the Gin context.
+// A nil value removes any value saved by a previous chain, as the field is then only known as a string.
+func saveTypedMatchedDataToCtx(ctx *gin.Context, location, field string, value any) {
+	if ctx == nil {
+		return
+	}
+
+	data, _ := ctx.Get(ginValidatorCtxTypedMatchedDataStoreName)
+	store, _ := data.(typedMatchedData)
+
+	if value == nil {
+		delete(store[location], field)
+		return
+	}
+
+	if store == nil {
+		store = make(typedMatchedData)
+		ctx.Set(ginValidatorCtxTypedMatchedDataStoreName, store)
+	}
+
+	if store[location] == nil {
+		store[location] = make(map[string]any)
+	}
+
+	store[location][field] = value
+}
+
+// getTypedMatchedData returns the Go values of the matched fields saved in the Gin context, if any.
+func getTypedMatchedData(ctx *gin.Context) typedMatchedData {
+	data, _ := ctx.Get(ginValidatorCtxTypedMatchedDataStoreName)
+	store, _ := data.(typedMatchedData)
+	return store
+}
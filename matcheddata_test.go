@@ -1,9 +1,13 @@
 package ginvalidator
 
 import (
+	"bytes"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -363,3 +367,120 @@ func TestMatchedData_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchedData_Values(t *testing.T) {
+	md := MatchedData{
+		"queries": MatchedDataFieldValues{
+			"tag":    "a",
+			"tag[0]": "a",
+			"tag[1]": "b",
+			"page":   "2",
+		},
+	}
+
+	tests := []struct {
+		name   string
+		loc    RequestLocation
+		field  string
+		values []string
+		ok     bool
+	}{
+		{name: "field sent more than once", loc: QueryLocation, field: "tag", values: []string{"a", "b"}, ok: true},
+		{name: "field declared with brackets", loc: QueryLocation, field: "tag[]", values: []string{"a", "b"}, ok: true},
+		{name: "field sent once", loc: QueryLocation, field: "page", values: []string{"2"}, ok: true},
+		{name: "missing field", loc: QueryLocation, field: "missing", values: nil, ok: false},
+		{name: "wrong location", loc: BodyLocation, field: "tag", values: nil, ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values, ok := md.Values(test.loc, test.field)
+
+			if ok != test.ok {
+				t.Errorf("got ok %v, want %v", ok, test.ok)
+			}
+
+			if !reflect.DeepEqual(values, test.values) {
+				t.Errorf("got %v, want %v", values, test.values)
+			}
+		})
+	}
+}
+
+func TestMatchedData_TypedAccessors(t *testing.T) {
+	md := MatchedValues{MatchedData: MatchedData{
+		"body": MatchedDataFieldValues{
+			"age":     "30",
+			"price":   "9.990000",
+			"active":  "true",
+			"created": "2024-05-01 10:30:00",
+			"name":    "John",
+		},
+	}}
+
+	if got, err := md.Int(BodyLocation, "age"); err != nil || got != 30 {
+		t.Errorf("Int: got %d, %v, want 30", got, err)
+	}
+
+	if got, err := md.Float(BodyLocation, "price"); err != nil || got != 9.99 {
+		t.Errorf("Float: got %g, %v, want 9.99", got, err)
+	}
+
+	if got, err := md.Bool(BodyLocation, "active"); err != nil || !got {
+		t.Errorf("Bool: got %v, %v, want true", got, err)
+	}
+
+	want := time.Date(2024, time.May, 1, 10, 30, 0, 0, time.UTC)
+	if got, err := md.Time(BodyLocation, "created"); err != nil || !got.Equal(want) {
+		t.Errorf("Time: got %v, %v, want %v", got, err, want)
+	}
+
+	if got, err := md.Strings(BodyLocation, "name"); err != nil || !reflect.DeepEqual(got, []string{"John"}) {
+		t.Errorf("Strings: got %v, %v, want [John]", got, err)
+	}
+
+	if _, err := md.Int(BodyLocation, "missing"); !errors.Is(err, ErrMatchedDataFieldNotFound) {
+		t.Errorf("Int: got error %v, want %v", err, ErrMatchedDataFieldNotFound)
+	}
+
+	if _, err := md.Int(BodyLocation, "name"); err == nil || errors.Is(err, ErrMatchedDataFieldNotFound) {
+		t.Errorf("Int: got error %v, want a conversion error", err)
+	}
+}
+
+func TestGetMatchedValues_PreferGoValues(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var md MatchedValues
+	var bound struct {
+		Price float64 `matched:"body:price"`
+	}
+
+	router := gin.New()
+	router.POST("/test",
+		NewBodyChain("price", nil).ToFloat().Validate(),
+		NewBodyChain("born", nil).ToDate().Validate(),
+		func(ctx *gin.Context) {
+			md, _ = GetMatchedValues(ctx)
+			_ = BindMatchedData(ctx, &bound)
+		},
+	)
+
+	req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(`{"price": "0.0000001", "born": "2024-05-01"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	// ToFloat writes 6 decimals, so the string form of the price has lost its value.
+	if got, _ := md.Get(BodyLocation, "price"); got != "0.000000" {
+		t.Fatalf("got the string form %q, want %q", got, "0.000000")
+	}
+
+	if got, err := md.Float(BodyLocation, "price"); err != nil || got != 0.0000001 || got != bound.Price {
+		t.Errorf("Float: got %g, %v, want 1e-07 as bound (%g)", got, err, bound.Price)
+	}
+
+	want := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	if got, err := md.Time(BodyLocation, "born"); err != nil || !got.Equal(want) {
+		t.Errorf("Time: got %v, %v, want %v", got, err, want)
+	}
+}
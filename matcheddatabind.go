@@ -0,0 +1,339 @@
+package ginvalidator
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MatchedDataTagName is the struct tag read by [BindMatchedData].
+const MatchedDataTagName string = "matched"
+
+// ErrBindInvalidDestination is returned by [BindMatchedData] when the destination is not a non-nil pointer to a struct.
+var ErrBindInvalidDestination = errors.New("bind matched data: destination must be a non-nil pointer to a struct")
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	fileHeaderType = reflect.TypeOf(&multipart.FileHeader{})
+)
+
+// BindMatchedData fills the struct pointed to by dst with the matched data of the request.
+//
+// Each field to fill is tagged with the request location and the field it is matched under, e.g.
+// `matched:"body:age"` or `matched:"query:tag"`. Locations can be written as in [RequestLocation.String] or in singular.
+// Fields are converted to the Go type of the struct field: strings, bools, integers, floats, time.Time,
+// slices of these (filled with every value of a field sent more than once) and pointers to these are supported,
+// as are *multipart.FileHeader fields for the files matched by a file chain (see [NewFile]), e.g. `matched:"files:avatar"`.
+// When the last sanitizer of a chain converted the value (e.g. ToInt or ToDate), that Go value is used as is,
+// so no precision is lost to its string form.
+//
+// A struct field tagged with a location and a field maps the fields of the nested struct below that path:
+// tags without a location name fields relative to the parent, so nested JSON paths map to nested structs.
+// A slice of structs is filled from indexed paths, as matched by wildcard chains (e.g. "items[0].sku").
+// Embedded structs are bound as if their fields were part of the parent. Untagged fields and fields tagged "-" are skipped,
+// as are fields that were not matched, which keep their value.
+//
+// Example:
+//
+//	type Signup struct {
+//	  Age     int      `matched:"body:age"`
+//	  Tags    []string `matched:"query:tag"`
+//	  Address struct {
+//	    City string `matched:"city"`
+//	  } `matched:"body:address"`
+//	}
+//
+//	var signup Signup
+//	if err := ginvalidator.BindMatchedData(ctx, &signup); err != nil {
+//	  // handle error
+//	}
+func BindMatchedData(ctx *gin.Context, dst any) error {
+	if ctx == nil {
+		return ErrNilCtxMatchedData
+	}
+
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrBindInvalidDestination
+	}
+
+	md, err := GetMatchedValues(ctx)
+	if err != nil {
+		return err
+	}
+
+	binder := matchedDataBinder{md: md}
+	_, err = binder.bindStruct(rv.Elem(), nil, "")
+
+	return err
+}
+
+// matchedDataBinder binds matched data, preferring the Go values of converted fields over their string form.
+type matchedDataBinder struct {
+	md MatchedValues
+}
+
+// bindStruct binds the tagged fields of a struct, resolving relative tags against the location and path prefix
+// of its parent. It reports whether any field was bound.
+func (b matchedDataBinder) bindStruct(v reflect.Value, loc *RequestLocation, prefix string) (bool, error) {
+	bound := false
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+
+		// Like encoding/json, the exported fields of an embedded struct of an unexported type are still bound.
+		if !sf.IsExported() && !(sf.Anonymous && sf.Type.Kind() == reflect.Struct) {
+			continue
+		}
+
+		tag, ok := sf.Tag.Lookup(MatchedDataTagName)
+		if tag == "-" {
+			continue
+		}
+
+		if !ok {
+			if sf.Anonymous && isBindableStruct(sf.Type) {
+				fieldBound, err := b.bindStructField(v.Field(i), loc, prefix)
+				if err != nil {
+					return false, err
+				}
+				bound = bound || fieldBound
+			}
+			continue
+		}
+
+		fieldLoc, path, err := resolveMatchedTag(tag, loc, prefix)
+		if err != nil {
+			return false, fmt.Errorf("bind matched data: field %s: %w", sf.Name, err)
+		}
+
+		var fieldBound bool
+		if isBindableStruct(sf.Type) {
+			fieldBound, err = b.bindStructField(v.Field(i), &fieldLoc, path)
+		} else {
+			fieldBound, err = b.bindField(v.Field(i), fieldLoc, path)
+		}
+
+		if err != nil {
+			return false, err
+		}
+		bound = bound || fieldBound
+	}
+
+	return bound, nil
+}
+
+// bindStructField binds a struct or pointer to struct field, only allocating the pointer when a field is bound.
+func (b matchedDataBinder) bindStructField(v reflect.Value, loc *RequestLocation, prefix string) (bool, error) {
+	if v.Kind() != reflect.Pointer {
+		return b.bindStruct(v, loc, prefix)
+	}
+
+	elem := reflect.New(v.Type().Elem())
+	bound, err := b.bindStruct(elem.Elem(), loc, prefix)
+	if bound && err == nil {
+		v.Set(elem)
+	}
+
+	return bound, err
+}
+
+// bindField binds a field that is not a struct. It reports whether the field was matched.
+func (b matchedDataBinder) bindField(v reflect.Value, loc RequestLocation, path string) (bool, error) {
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		return b.bindSlice(v, loc, path)
+	}
+
+	value, ok := b.md.Get(loc, path)
+	if !ok {
+		return false, nil
+	}
+
+	if err := setMatchedValue(v, value, b.md.typed[loc.String()][path]); err != nil {
+		return false, fmt.Errorf("bind matched data: %s %q: %w", loc, path, err)
+	}
+
+	return true, nil
+}
+
+// bindSlice binds every value of a field sent more than once, or the indexed paths matched by a wildcard chain for slices of structs.
+func (b matchedDataBinder) bindSlice(v reflect.Value, loc RequestLocation, path string) (bool, error) {
+	elemType := v.Type().Elem()
+
+	if isBindableStruct(elemType) {
+		slice := reflect.MakeSlice(v.Type(), 0, 0)
+
+		for i := 0; ; i++ {
+			elem := reflect.New(elemType).Elem()
+
+			bound, err := b.bindStructField(elem, &loc, indexedField(path, i))
+			if err != nil {
+				return false, err
+			}
+			if !bound {
+				break
+			}
+
+			slice = reflect.Append(slice, elem)
+		}
+
+		if slice.Len() == 0 {
+			return false, nil
+		}
+
+		v.Set(slice)
+		return true, nil
+	}
+
+	values, ok := b.md.Values(loc, path)
+	if !ok {
+		return false, nil
+	}
+
+	slice := reflect.MakeSlice(v.Type(), len(values), len(values))
+	for i, value := range values {
+		typed := b.md.typed[loc.String()][path]
+		if _, indexed := b.md.Get(loc, indexedField(path, i)); indexed {
+			typed = b.md.typed[loc.String()][indexedField(path, i)]
+		}
+
+		if err := setMatchedValue(slice.Index(i), value, typed); err != nil {
+			return false, fmt.Errorf("bind matched data: %s %q: %w", loc, indexedField(path, i), err)
+		}
+	}
+
+	v.Set(slice)
+	return true, nil
+}
+
+// resolveMatchedTag resolves a tag into the location and path it binds.
+// A tag naming a location is absolute, any other tag is relative to the location and path prefix of its parent.
+func resolveMatchedTag(tag string, parentLoc *RequestLocation, prefix string) (RequestLocation, string, error) {
+	locName, field, hasLoc := strings.Cut(tag, ":")
+
+	if !hasLoc {
+		if parentLoc == nil {
+			return 0, "", fmt.Errorf("tag %q names no request location", tag)
+		}
+		if tag == "" {
+			return 0, "", fmt.Errorf("tag %q names no field", tag)
+		}
+		if prefix == "" {
+			return *parentLoc, tag, nil
+		}
+		return *parentLoc, prefix + "." + tag, nil
+	}
+
+	loc, ok := parseRequestLocation(locName)
+	if !ok {
+		return 0, "", fmt.Errorf("tag %q names an unknown request location", tag)
+	}
+
+	if field == "" {
+		return 0, "", fmt.Errorf("tag %q names no field", tag)
+	}
+
+	return loc, field, nil
+}
+
+// isBindableStruct reports whether a type is a struct, or a pointer to one, whose fields are bound individually.
+func isBindableStruct(t reflect.Type) bool {
+	if t == fileHeaderType {
+		return false
+	}
+
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+// setMatchedValue sets v from a matched value, using its Go value when a sanitizer converted it to a compatible type.
+func setMatchedValue(v reflect.Value, value string, typed any) error {
+	if v.Type() == fileHeaderType {
+		file, ok := typed.(*multipart.FileHeader)
+		if !ok {
+			return fmt.Errorf("%q is not a file", value)
+		}
+		v.Set(reflect.ValueOf(file))
+		return nil
+	}
+
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		if err := setMatchedValue(elem.Elem(), value, typed); err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	if v.Type() == timeType {
+		t, ok := typed.(time.Time)
+		if !ok {
+			var err error
+			if t, err = parseMatchedTime(value); err != nil {
+				return err
+			}
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, ok := typed.(bool)
+		if !ok {
+			var err error
+			if b, err = strconv.ParseBool(value); err != nil {
+				return err
+			}
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := typed.(int)
+		num := int64(n)
+		if !ok {
+			var err error
+			if num, err = strconv.ParseInt(value, 10, v.Type().Bits()); err != nil {
+				return err
+			}
+		}
+		if v.OverflowInt(num) {
+			return fmt.Errorf("%d overflows %s", num, v.Type())
+		}
+		v.SetInt(num)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		num, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(num)
+	case reflect.Float32, reflect.Float64:
+		num, ok := typed.(float64)
+		if !ok {
+			var err error
+			if num, err = strconv.ParseFloat(value, v.Type().Bits()); err != nil {
+				return err
+			}
+		}
+		if v.OverflowFloat(num) {
+			return fmt.Errorf("%g overflows %s", num, v.Type())
+		}
+		v.SetFloat(num)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package ginvalidator
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+)
+
+type bindAddress struct {
+	City string `matched:"city"`
+	Zip  *int   `matched:"zip"`
+}
+
+type bindItem struct {
+	SKU string `matched:"sku"`
+	Qty int    `matched:"qty"`
+}
+
+type bindPaging struct {
+	Page int `matched:"query:page"`
+}
+
+type bindSignup struct {
+	bindPaging
+
+	Name     string       `matched:"body:name"`
+	Age      int64        `matched:"body:age"`
+	Price    float64      `matched:"body:price"`
+	Admin    bool         `matched:"body:admin"`
+	Born     time.Time    `matched:"body:born"`
+	Tags     []string     `matched:"query:tag"`
+	IDs      []uint       `matched:"queries:id"`
+	Address  bindAddress  `matched:"body:address"`
+	Billing  *bindAddress `matched:"body:billing"`
+	Items    []bindItem   `matched:"body:items"`
+	Nickname *string      `matched:"body:nickname"`
+	Ignored  string       `matched:"-"`
+	Untagged string
+}
+
+func TestBindMatchedData(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	var (
+		got bindSignup
+		err error
+	)
+
+	router.POST("/test",
+		NewBodyChain("name", nil).Validate(),
+		NewBodyChain("age", nil).ToInt().Validate(),
+		NewBodyChain("price", nil).ToFloat().Validate(),
+		NewBodyChain("admin", nil).ToBoolean(true).Validate(),
+		NewBodyChain("born", nil).Validate(),
+		NewBodyChain("address.city", nil).Validate(),
+		NewBodyChain("address.zip", nil).ToInt().Validate(),
+		NewBodyChain("items.*.sku", nil).Validate(),
+		NewBodyChain("items.*.qty", nil).ToInt().Validate(),
+		NewQueryChain("tag", nil).Validate(),
+		NewQueryChain("id", nil).Validate(),
+		NewQueryChain("page", nil).ToInt().Validate(),
+		func(ctx *gin.Context) {
+			got = bindSignup{Ignored: "kept", Untagged: "kept"}
+			err = BindMatchedData(ctx, &got)
+		},
+	)
+
+	body := `{"name":"John","age":"42","price":"0.1234567","admin":"1","born":"1990-01-02","address":{"city":"Lagos","zip":"100001"},"items":[{"sku":"A1","qty":"2"},{"sku":"B2","qty":"5"}]}`
+	req, _ := http.NewRequest(http.MethodPost, "/test?tag=go&tag=web&id=1&id=2&page=3", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	zip := 100001
+	want := bindSignup{
+		bindPaging: bindPaging{Page: 3},
+		Name:       "John",
+		Age:        42,
+		Price:      0.1234567,
+		Admin:      true,
+		Born:       time.Date(1990, time.January, 2, 0, 0, 0, 0, time.UTC),
+		Tags:       []string{"go", "web"},
+		IDs:        []uint{1, 2},
+		Address:    bindAddress{City: "Lagos", Zip: &zip},
+		Items:      []bindItem{{SKU: "A1", Qty: 2}, {SKU: "B2", Qty: 5}},
+		Ignored:    "kept",
+		Untagged:   "kept",
+	}
+
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(bindSignup{})); diff != "" {
+		t.Errorf("BindMatchedData mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestBindMatchedDataErrors(t *testing.T) {
+	ctx := createTestGinCtx(ginCtxReqOpts{})
+	saveMatchedDataToCtx(ctx, "body", "age", "old")
+
+	var dst struct {
+		Age int `matched:"body:age"`
+	}
+
+	tests := []struct {
+		name string
+		ctx  *gin.Context
+		dst  any
+		err  error
+	}{
+		{name: "Nil context.", ctx: nil, dst: &dst, err: ErrNilCtxMatchedData},
+		{name: "Non-pointer destination.", ctx: ctx, dst: dst, err: ErrBindInvalidDestination},
+		{name: "Nil destination.", ctx: ctx, dst: (*struct{})(nil), err: ErrBindInvalidDestination},
+		{name: "No matched data.", ctx: createTestGinCtx(ginCtxReqOpts{}), dst: &dst, err: ErrNoMatchedData},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := BindMatchedData(test.ctx, test.dst); !errors.Is(err, test.err) {
+				t.Errorf("got error %v, want %v", err, test.err)
+			}
+		})
+	}
+
+	t.Run("Conversion error.", func(t *testing.T) {
+		if err := BindMatchedData(ctx, &dst); err == nil {
+			t.Error("expected an error for a value that is not an integer")
+		}
+	})
+
+	t.Run("Relative tag at the top level.", func(t *testing.T) {
+		var relative struct {
+			Age int `matched:"age"`
+		}
+		if err := BindMatchedData(ctx, &relative); err == nil {
+			t.Error("expected an error for a tag without a request location")
+		}
+	})
+}
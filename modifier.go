@@ -2,16 +2,19 @@ package ginvalidator
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	BailModifierName     string = "Bail"
-	IfModifierName       string = "If"
-	NotModifierName      string = "Not"
-	SkipModifierName     string = "Skip"
-	OptionalModifierName string = "Optional"
+	BailModifierName      string = "Bail"
+	IfModifierName        string = "If"
+	NotModifierName       string = "Not"
+	SkipModifierName      string = "Skip"
+	OptionalModifierName  string = "Optional"
+	SensitiveModifierName string = "Sensitive"
 )
 
 // A modifier is simply a piece of the validation chain that can manipulate the whole validation chain.
@@ -19,32 +22,42 @@ type modifier struct {
 	field      string            // the field to be specified
 	errFmtFunc ErrFmtFunc // the function to create the error message
 
-	reqLoc            RequestLocation  // the HTTP request location (e.g., body, headers, cookies, params, or queries)
-	rulesCreatorFuncs ruleCreatorFuncs // the list of functions that creates the validation rules.
+	reqLoc            RequestLocation   // the HTTP request location (e.g., body, headers, cookies, params, or queries)
+	locations         []RequestLocation // the locations searched, in order, by a chain created with NewCheck; nil for any other chain
+	rulesCreatorFuncs ruleCreatorFuncs  // the list of functions that creates the validation rules.
+	ruleDescriptors   ruleDescriptors   // the descriptors of the validation rules, in the same order.
 }
 
 // recreateValidationChainFromModifier takes the previous modifier and returns a new validation chain.
-func (m *modifier) recreateValidationChainFromModifier(ruleCreatorFunc ruleCreatorFunc) ValidationChain {
-	newRulesCreatorFunc := append(m.rulesCreatorFuncs, ruleCreatorFunc)
+func (m *modifier) recreateValidationChainFromModifier(ruleCreatorFunc ruleCreatorFunc, name string) ValidationChain {
+	// Cap the slices so that chains sharing a common prefix never overwrite each other's rules.
+	newRulesCreatorFunc := append(m.rulesCreatorFuncs[:len(m.rulesCreatorFuncs):len(m.rulesCreatorFuncs)], ruleCreatorFunc)
+	newRuleDescriptors := append(m.ruleDescriptors[:len(m.ruleDescriptors):len(m.ruleDescriptors)], ruleDescriptor{chainType: modifierType, name: name})
 
 	return ValidationChain{
 		validator: validator{
 			field:             m.field,
 			reqLoc:            m.reqLoc,
+			locations:         m.locations,
 			errFmtFunc:        m.errFmtFunc,
 			rulesCreatorFuncs: newRulesCreatorFunc,
+			ruleDescriptors:   newRuleDescriptors,
 		},
 		modifier: modifier{
 			field:             m.field,
 			reqLoc:            m.reqLoc,
+			locations:         m.locations,
 			errFmtFunc:        m.errFmtFunc,
 			rulesCreatorFuncs: newRulesCreatorFunc,
+			ruleDescriptors:   newRuleDescriptors,
 		},
 		sanitizer: sanitizer{
 			field:             m.field,
 			reqLoc:            m.reqLoc,
+			locations:         m.locations,
 			errFmtFunc:        m.errFmtFunc,
 			rulesCreatorFuncs: newRulesCreatorFunc,
+			ruleDescriptors:   newRuleDescriptors,
 		},
 	}
 }
@@ -66,7 +79,7 @@ func (m modifier) Bail() ValidationChain {
 		)
 	}
 
-	return m.recreateValidationChainFromModifier(ruleCreator)
+	return m.recreateValidationChainFromModifier(ruleCreator, BailModifierName)
 }
 
 // IfModifierFunc defines a function that determines whether the validation chain should stop or continue.
@@ -98,7 +111,7 @@ func (m modifier) If(imf IfModifierFunc) ValidationChain {
 		)
 	}
 
-	return m.recreateValidationChainFromModifier(ruleCreator)
+	return m.recreateValidationChainFromModifier(ruleCreator, IfModifierName)
 }
 
 // Not negates the result of the next validator in the chain.
@@ -113,7 +126,7 @@ func (m modifier) Not() ValidationChain {
 		)
 	}
 
-	return m.recreateValidationChainFromModifier(ruleCreator)
+	return m.recreateValidationChainFromModifier(ruleCreator, NotModifierName)
 }
 
 // SkipModifierFunc defines a function that determines wwhether the next validator, modifier or sanitizer in validation chain should be skipped.
@@ -146,11 +159,24 @@ func (m modifier) Skip(smf SkipModifierFunc) ValidationChain {
 		)
 	}
 
-	return m.recreateValidationChainFromModifier(ruleCreator)
+	return m.recreateValidationChainFromModifier(ruleCreator, SkipModifierName)
 }
 
-// Optional ignores validation if the value is not present/empty, instead of failing it.
+// Optional ignores validation if the value is missing, empty or a JSON null, instead of failing it.
+//
+// Use [modifier.OptionalFor] to choose which values count as absent.
 func (m modifier) Optional() ValidationChain {
+	return m.OptionalFor(AbsentNull | AbsentEmpty)
+}
+
+// OptionalFor ignores validation if the field is missing or its value is one of the absent values, instead of failing it.
+// It is like express-validator's optional({ values }):
+//   - AbsentMissing: only a missing field is ignored ("undefined").
+//   - AbsentNull: a JSON null is ignored as well ("null").
+//   - AbsentFalsy: every falsy value is ignored, i.e. null, "", 0 and false ("falsy").
+//
+// The values can also be combined, e.g. OptionalFor(AbsentNull | AbsentEmpty), which is what Optional does.
+func (m modifier) OptionalFor(values AbsentValues) ValidationChain {
 	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
 		return newValidationChainRule(
 			withIsValid(true),
@@ -159,10 +185,96 @@ func (m modifier) Optional() ValidationChain {
 			withValidationChainType(modifierType),
 			withShouldBail(false),
 			withShouldSkip(false),
+			withAbsentValues(values),
 		)
 	}
 
-	return m.recreateValidationChainFromModifier(ruleCreator)
+	return m.recreateValidationChainFromModifier(ruleCreator, OptionalModifierName)
+}
+
+// Sensitive marks the field as sensitive, e.g. a password or a card number, so that its value is masked
+// in the errors reported for it according to the [RedactionPolicy]. Matched data still holds the actual value.
+// You can put it anywhere in the chain — position doesn't matter.
+func (m modifier) Sensitive() ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		return newValidationChainRule(
+			withIsValid(true),
+			withNewValue(sanitizedValue),
+			withValidationChainName(SensitiveModifierName),
+			withValidationChainType(modifierType),
+			withShouldBail(false),
+			withShouldSkip(false),
+		)
+	}
+
+	return m.recreateValidationChainFromModifier(ruleCreator, SensitiveModifierName)
+}
+
+// AbsentValues is a set of values that count as absent for the Optional modifier and the Exists validator,
+// besides a field missing from the request, which is always absent.
+type AbsentValues int
+
+const (
+	// AbsentNull counts a JSON null as absent.
+	AbsentNull AbsentValues = 1 << iota
+
+	// AbsentEmpty counts an empty value as absent, e.g. `"field": ""` or an empty query value.
+	AbsentEmpty
+
+	// AbsentZero counts a JSON number equal to 0 as absent.
+	AbsentZero
+
+	// AbsentFalse counts a JSON false as absent.
+	AbsentFalse
+
+	// AbsentMissing counts only a field missing from the request as absent.
+	AbsentMissing AbsentValues = 0
+
+	// AbsentFalsy counts every falsy value as absent: null, "", 0 and false.
+	AbsentFalsy = AbsentNull | AbsentEmpty | AbsentZero | AbsentFalse
+)
+
+// includes reports whether a value of the given kind counts as absent.
+func (a AbsentValues) includes(kind valueKind, value string) bool {
+	switch kind {
+	case missingValue:
+		return true
+	case nullValue:
+		return a&AbsentNull != 0
+	case stringValue:
+		return a&AbsentEmpty != 0 && value == ""
+	case numberValue:
+		n, err := strconv.ParseFloat(value, 64)
+		return a&AbsentZero != 0 && err == nil && n == 0
+	case boolValue:
+		return a&AbsentFalse != 0 && value == "false"
+	default:
+		return false
+	}
+}
+
+// String returns the names of the absent values, e.g. "null|empty", or "missing" for AbsentMissing.
+func (a AbsentValues) String() string {
+	if a == AbsentMissing {
+		return "missing"
+	}
+
+	var names []string
+	for _, value := range []struct {
+		flag AbsentValues
+		name string
+	}{
+		{AbsentNull, "null"},
+		{AbsentEmpty, "empty"},
+		{AbsentZero, "zero"},
+		{AbsentFalse, "false"},
+	} {
+		if a&value.flag != 0 {
+			names = append(names, value.name)
+		}
+	}
+
+	return strings.Join(names, "|")
 }
 
 // newModifier creates and returns a new modifier.
@@ -45,7 +45,7 @@ func TestBail(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -116,7 +116,7 @@ func TestIf(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -167,7 +167,7 @@ func TestNot(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -240,7 +240,7 @@ func TestSkip(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -275,6 +275,7 @@ func TestOptional(t *testing.T) {
 				withValidationChainType(modifierType),
 				withShouldBail(false),
 				withShouldSkip(false),
+				withAbsentValues(AbsentNull|AbsentEmpty),
 			),
 		},
 	}
@@ -294,7 +295,7 @@ func TestOptional(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -303,3 +304,35 @@ func TestOptional(t *testing.T) {
 		})
 	}
 }
+
+func TestAbsentValuesIncludes(t *testing.T) {
+	tests := []struct {
+		name   string
+		values AbsentValues
+		kind   valueKind
+		value  string
+
+		want bool
+	}{
+		{name: "Missing is always absent.", values: AbsentMissing, kind: missingValue, want: true},
+		{name: "Null is present for missing.", values: AbsentMissing, kind: nullValue, want: false},
+		{name: "Null is absent for null.", values: AbsentNull, kind: nullValue, want: true},
+		{name: "Empty string is present for null.", values: AbsentNull, kind: stringValue, value: "", want: false},
+		{name: "Empty string is absent for empty.", values: AbsentEmpty, kind: stringValue, value: "", want: true},
+		{name: "Zero is present for empty.", values: AbsentNull | AbsentEmpty, kind: numberValue, value: "0", want: false},
+		{name: "Zero is absent for falsy.", values: AbsentFalsy, kind: numberValue, value: "0.0", want: true},
+		{name: "Non-zero number is present for falsy.", values: AbsentFalsy, kind: numberValue, value: "0.5", want: false},
+		{name: "String zero is present for falsy.", values: AbsentFalsy, kind: stringValue, value: "0", want: false},
+		{name: "False is absent for falsy.", values: AbsentFalsy, kind: boolValue, value: "false", want: true},
+		{name: "True is present for falsy.", values: AbsentFalsy, kind: boolValue, value: "true", want: false},
+		{name: "Empty object is present for falsy.", values: AbsentFalsy, kind: objectValue, value: "{}", want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.values.includes(test.kind, test.value); got != test.want {
+				t.Errorf("got %t, want %t", got, test.want)
+			}
+		})
+	}
+}
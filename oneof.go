@@ -1,8 +1,6 @@
 package ginvalidator
 
 import (
-	"sync/atomic"
-
 	"github.com/gin-gonic/gin"
 )
 
@@ -27,29 +25,43 @@ func OneOf(chainGroups ...[]ValidationChain) gin.HandlerFunc {
 		for _, group := range chainGroups {
 			var groupErrors []ValidationChainError
 			var groupResults []chainResult
+			groupUndecided := false
 
 			for _, chain := range group {
-				result := chain.validate(ctx)
-				groupErrors = append(groupErrors, result.errors...)
-				groupResults = append(groupResults, result)
+				for _, result := range chain.validate(ctx) {
+					// A group whose validity is unknown cannot pass.
+					if result.internalErr != nil {
+						handleInternalErr(ctx, result.internalErr)
+						if ctx.IsAborted() {
+							return
+						}
+						groupUndecided = true
+					}
+
+					groupErrors = append(groupErrors, result.errors...)
+					groupResults = append(groupResults, result)
+				}
 			}
 
-			if len(groupErrors) == 0 {
+			if len(groupErrors) == 0 && !groupUndecided {
 				for _, result := range groupResults {
-					saveMatchedDataToCtx(ctx, result.location, result.field, result.sanitizedValue)
+					saveChainResultToCtx(ctx, result)
 				}
 				ctx.Next()
 				return
 			}
 		}
 
-		order := atomic.AddUint64(&globalErrorOrder, 1)
+		errMsg, ok := translateErrMsg(ctx, messageData{field: "_oneOf", validator: "OneOf"})
+		if !ok {
+			errMsg = "No group in OneOf passed validation"
+		}
+
 		oneOfErr := newValidationChainError(
 			vceWithLocation(""),
-			vceWithMessage("No group in OneOf passed validation"),
+			vceWithMessage(errMsg),
 			vceWithField("_oneOf"),
 			vceWithValue(""),
-			vceWithOrder(order),
 		)
 		saveValidationErrorsToCtx(ctx, []ValidationChainError{oneOfErr})
 		ctx.Next()
@@ -0,0 +1,394 @@
+package ginvalidator
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// OpenAPIOperation is the fragment of an OpenAPI 3.1 operation object describing the input of a route:
+// its parameters and request body.
+type OpenAPIOperation struct {
+	Parameters  []OpenAPIParameter  `json:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody `json:"requestBody,omitempty"`
+}
+
+// OpenAPIParameter is an OpenAPI 3.1 parameter object.
+type OpenAPIParameter struct {
+	Name     string         `json:"name"`
+	In       string         `json:"in"` // query, header, path or cookie
+	Required bool           `json:"required,omitempty"`
+	Schema   *OpenAPISchema `json:"schema,omitempty"`
+}
+
+// OpenAPIRequestBody is an OpenAPI 3.1 request body object.
+type OpenAPIRequestBody struct {
+	Required bool                        `json:"required,omitempty"`
+	Content  map[string]OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIMediaType is an OpenAPI 3.1 media type object.
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPISchema is the subset of the JSON Schema of OpenAPI 3.1 the validators of a chain map to.
+type OpenAPISchema struct {
+	Type             string                    `json:"type,omitempty"`
+	Format           string                    `json:"format,omitempty"`
+	Enum             []any                     `json:"enum,omitempty"`
+	Pattern          string                    `json:"pattern,omitempty"`
+	MinLength        *uint                     `json:"minLength,omitempty"`
+	MaxLength        *uint                     `json:"maxLength,omitempty"`
+	Minimum          *float64                  `json:"minimum,omitempty"`
+	Maximum          *float64                  `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64                  `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64                  `json:"exclusiveMaximum,omitempty"`
+	MultipleOf       *float64                  `json:"multipleOf,omitempty"`
+	MinItems         *int                      `json:"minItems,omitempty"`
+	MaxItems         *int                      `json:"maxItems,omitempty"`
+	UniqueItems      bool                      `json:"uniqueItems,omitempty"`
+	Items            *OpenAPISchema            `json:"items,omitempty"`
+	Properties       map[string]*OpenAPISchema `json:"properties,omitempty"`
+	Required         []string                  `json:"required,omitempty"`
+}
+
+// OpenAPI describes the input the chains validate as an OpenAPI 3.1 operation fragment.
+//
+// Query, header, path and cookie chains become parameters, and body chains the properties of the JSON Schema
+// of an application/json request body, nested along their gjson paths ("*" and indexes are array items).
+// File chains make it a multipart/form-data body, whose files are binary strings, or arrays of them
+// when ItemCount lets a field hold more than one file.
+// A field is required unless its chain is optional, or has no validators; path parameters are always required.
+// Chains created with [NewCheck] are documented, as optional, in every location they search.
+//
+// The validators map to constraints where JSON Schema has one: Length to minLength and maxLength, Email, URL,
+// UUID, IP, FQDN and RFC3339 to formats, In and Equals to an enum, Int and Float to an integer or number type
+// with its bounds, Numeric and Decimal to a number, Boolean to a boolean, DivisibleBy to multipleOf,
+// Matches to a pattern, and Array, ItemCount and UniqueItems to array constraints. A validator negated by Not
+// is left out, except Not().Empty(), which requires a minLength of 1. Other validators are not described.
+//
+// Example:
+//
+//	op := ginvalidator.OpenAPI(
+//	  ginvalidator.NewBodyChain("user.email", nil).Email(nil),
+//	  ginvalidator.NewQueryChain("page", nil).Optional().Int(&vgo.IsIntOpts{Min: &one}),
+//	)
+//	spec, _ := json.Marshal(op)
+func OpenAPI(chains ...ValidationChain) OpenAPIOperation {
+	b := newOpenAPIBuilder()
+	for _, chain := range chains {
+		b.addChain(chain, false)
+	}
+
+	return b.operation()
+}
+
+// OpenAPI describes the input the schema validates as an OpenAPI 3.1 operation fragment, like [OpenAPI].
+func (schema Schema) OpenAPI() OpenAPIOperation {
+	return OpenAPI(schema.chains()...)
+}
+
+// openAPIBuilder builds an operation from chains, merging the constraints of chains validating the same field.
+type openAPIBuilder struct {
+	parameters   []OpenAPIParameter
+	body         *OpenAPISchema
+	bodyRequired bool
+	files        bool // whether the body has files, making it multipart/form-data
+}
+
+// newOpenAPIBuilder returns a builder of an operation without parameters nor body.
+func newOpenAPIBuilder() *openAPIBuilder {
+	return &openAPIBuilder{body: &OpenAPISchema{Type: "object"}}
+}
+
+// operation returns the operation built.
+func (b *openAPIBuilder) operation() OpenAPIOperation {
+	op := OpenAPIOperation{Parameters: b.parameters}
+
+	if len(b.body.Properties) > 0 {
+		mediaType := "application/json"
+		if b.files {
+			mediaType = "multipart/form-data"
+		}
+
+		op.RequestBody = &OpenAPIRequestBody{
+			Required: b.bodyRequired,
+			Content:  map[string]OpenAPIMediaType{mediaType: {Schema: b.body}},
+		}
+	}
+
+	return op
+}
+
+// addChain adds the field a chain validates. An optional chain, e.g. of a OneOf group, is never required.
+func (b *openAPIBuilder) addChain(chain ValidationChain, optional bool) {
+	required := !optional && chain.validator.locations == nil && openAPIRequired(chain.validator.ruleDescriptors)
+
+	locations := chain.validator.locations
+	if locations == nil {
+		locations = []RequestLocation{chain.validator.reqLoc}
+	}
+
+	for _, loc := range locations {
+		var schema *OpenAPISchema
+		switch loc {
+		case BodyLocation:
+			schema = b.bodyField(chain.validator.field, required)
+		case FileLocation:
+			schema = b.bodyField(escapeFieldPathKey(chain.validator.field), required)
+			b.files = true
+		default:
+			schema = b.parameter(loc, chain.validator.field, required)
+		}
+
+		if schema != nil {
+			describeOpenAPIRules(schema, chain.validator.ruleDescriptors)
+		}
+
+		if loc == FileLocation {
+			describeOpenAPIFile(schema)
+		}
+	}
+}
+
+// describeOpenAPIFile makes the schema of a file field a binary string,
+// or an array of them when ItemCount lets the field hold more than one file.
+func describeOpenAPIFile(schema *OpenAPISchema) {
+	file := OpenAPISchema{Type: "string", Format: "binary"}
+
+	if schema.Type != "array" || (schema.MaxItems != nil && *schema.MaxItems == 1) {
+		*schema = file
+		return
+	}
+
+	schema.Items = &file
+}
+
+// parameter returns the schema of a parameter, adding the parameter when it is new.
+func (b *openAPIBuilder) parameter(loc RequestLocation, name string, required bool) *OpenAPISchema {
+	in := map[RequestLocation]string{QueryLocation: "query", HeaderLocation: "header", ParamLocation: "path", CookieLocation: "cookie"}[loc]
+
+	for i := range b.parameters {
+		if b.parameters[i].In == in && b.parameters[i].Name == name {
+			b.parameters[i].Required = b.parameters[i].Required || required
+			return b.parameters[i].Schema
+		}
+	}
+
+	b.parameters = append(b.parameters, OpenAPIParameter{
+		Name:     name,
+		In:       in,
+		Required: required || loc == ParamLocation,
+		Schema:   &OpenAPISchema{Type: "string"},
+	})
+
+	return b.parameters[len(b.parameters)-1].Schema
+}
+
+// bodyField returns the schema of a body field, adding the objects and arrays along its path.
+// When the field is required, so are the keys of its path after the last wildcard, as a wildcard may match no element.
+// It returns nil for a path with a "**" segment, which JSON Schema cannot describe.
+func (b *openAPIBuilder) bodyField(field string, required bool) *OpenAPISchema {
+	segments := splitFieldPath(field)
+	if slices.Contains(segments, globstarSegment) {
+		return nil
+	}
+
+	lastWildcard := -1
+	for i, segment := range segments {
+		if segment == wildcardSegment {
+			lastWildcard = i
+		}
+	}
+
+	if required && lastWildcard < 0 {
+		b.bodyRequired = true
+	}
+
+	node := b.body
+	for i, segment := range segments {
+		if segment == wildcardSegment || isArrayIndex(segment) {
+			node.Type = "array"
+			if node.Items == nil {
+				node.Items = &OpenAPISchema{}
+			}
+			node = node.Items
+			continue
+		}
+
+		key := unescapeFieldPathKey(segment)
+
+		node.Type = "object"
+		if node.Properties == nil {
+			node.Properties = make(map[string]*OpenAPISchema)
+		}
+
+		if required && i > lastWildcard && !slices.Contains(node.Required, key) {
+			node.Required = append(node.Required, key)
+		}
+
+		child, ok := node.Properties[key]
+		if !ok {
+			child = &OpenAPISchema{}
+			node.Properties[key] = child
+		}
+		node = child
+	}
+
+	if node.Type == "" {
+		node.Type = "string"
+	}
+
+	return node
+}
+
+// unescapeFieldPathKey removes the escapes of a segment of a field path, the reverse of [escapeFieldPathKey].
+func unescapeFieldPathKey(segment string) string {
+	var key strings.Builder
+
+	for i := 0; i < len(segment); i++ {
+		if segment[i] == '\\' && i+1 < len(segment) {
+			i++
+		}
+		key.WriteByte(segment[i])
+	}
+
+	return key.String()
+}
+
+// openAPIRequired reports whether a chain requires its field: it is not optional and has a validator.
+func openAPIRequired(descriptors ruleDescriptors) bool {
+	hasValidator := false
+
+	for _, rd := range descriptors {
+		if rd.chainType == modifierType && rd.name == OptionalModifierName {
+			return false
+		}
+		hasValidator = hasValidator || rd.chainType == validatorType
+	}
+
+	return hasValidator
+}
+
+// describeOpenAPIRules adds the constraints of the validators of a chain to the schema of its field.
+func describeOpenAPIRules(schema *OpenAPISchema, descriptors ruleDescriptors) {
+	negated := false
+
+	for _, rd := range descriptors {
+		if rd.chainType == modifierType {
+			negated = negated || rd.name == NotModifierName
+			continue
+		}
+
+		if rd.chainType != validatorType {
+			continue
+		}
+
+		if negated {
+			negated = false
+
+			if rd.name == EmptyValidatorName && (schema.MinLength == nil || *schema.MinLength == 0) {
+				schema.MinLength = ptrTo(uint(1))
+			}
+			continue
+		}
+
+		describeOpenAPIRule(schema, rd)
+	}
+}
+
+// describeOpenAPIRule adds the constraints of a validator to a schema.
+func describeOpenAPIRule(schema *OpenAPISchema, rd ruleDescriptor) {
+	switch rd.name {
+	case LengthValidatorName:
+		if min, ok := openAPINumber(rd.params["min"]); ok && min > 0 {
+			schema.MinLength = ptrTo(uint(min))
+		}
+		if max, ok := openAPINumber(rd.params["max"]); ok {
+			schema.MaxLength = ptrTo(uint(max))
+		}
+	case EmailValidatorName:
+		schema.Format = "email"
+	case URLValidatorName:
+		schema.Format = "uri"
+	case UUIDValidatorName:
+		schema.Format = "uuid"
+	case FQDNValidatorName:
+		schema.Format = "hostname"
+	case RFC3339ValidatorName:
+		schema.Format = "date-time"
+	case IPValidatorName:
+		switch rd.params["version"] {
+		case "4":
+			schema.Format = "ipv4"
+		case "6":
+			schema.Format = "ipv6"
+		}
+	case InValidatorName:
+		if values, ok := rd.params["values"].([]string); ok {
+			schema.Enum = make([]any, len(values))
+			for i, value := range values {
+				schema.Enum[i] = value
+			}
+		}
+	case EqualsValidatorName:
+		schema.Enum = []any{rd.params["comparison"]}
+	case MatchesValidatorName:
+		if re, ok := rd.params["re"].(*regexp.Regexp); ok {
+			schema.Pattern = re.String()
+		}
+	case IntValidatorName, FloatValidatorName:
+		schema.Type = "number"
+		if rd.name == IntValidatorName {
+			schema.Type = "integer"
+		}
+		for key, bound := range map[string]**float64{"min": &schema.Minimum, "max": &schema.Maximum, "gt": &schema.ExclusiveMinimum, "lt": &schema.ExclusiveMaximum} {
+			if n, ok := openAPINumber(rd.params[key]); ok {
+				*bound = &n
+			}
+		}
+	case NumericValidatorName, DecimalValidatorName:
+		schema.Type = "number"
+	case BooleanValidatorName:
+		schema.Type = "boolean"
+	case DivisibleByValidatorName:
+		if n, ok := openAPINumber(rd.params["num"]); ok {
+			schema.MultipleOf = &n
+		}
+	case ArrayValidatorName:
+		schema.Type = "array"
+	case ItemCountValidatorName:
+		schema.Type = "array"
+		if min, ok := rd.params["min"].(int); ok && min > 0 {
+			schema.MinItems = &min
+		}
+		if max, ok := rd.params["max"].(int); ok {
+			schema.MaxItems = &max
+		}
+	case UniqueItemsValidatorName:
+		schema.Type = "array"
+		schema.UniqueItems = true
+	case ObjectValidatorName:
+		schema.Type = "object"
+	}
+}
+
+// openAPINumber converts a numeric param of a rule to a float64.
+func openAPINumber(param any) (float64, bool) {
+	switch n := param.(type) {
+	case int:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case float64:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+// ptrTo returns a pointer to v.
+func ptrTo[V any](v V) *V {
+	return &v
+}
@@ -0,0 +1,181 @@
+package ginvalidator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+)
+
+// jsonOf returns the value decoded from the JSON encoding of v, to compare it with the expected JSON.
+func jsonOf(t *testing.T, v any) any {
+	t.Helper()
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+// decodeJSON decodes the expected JSON of a test.
+func decodeJSON(t *testing.T, raw string) any {
+	t.Helper()
+
+	var decoded any
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func TestOpenAPI(t *testing.T) {
+	one, ten := 1, 10
+	maxLen := uint(64)
+
+	tests := []struct {
+		name   string
+		chains []ValidationChain
+		want   string
+	}{
+		{
+			name: "Maps parameters and their constraints.",
+			chains: []ValidationChain{
+				NewQueryChain("page", nil).Optional().Int(&vgo.IsIntOpts{Min: &one, Max: &ten}),
+				NewHeaderChain("X-Request-ID", nil).UUID("4"),
+				NewParamChain("slug", nil).Matches(regexp.MustCompile(`^[a-z-]+$`)),
+				NewCookieChain("theme", nil).Optional().In([]string{"dark", "light"}),
+				NewQueryChain("page", nil).Optional().DivisibleBy(2),
+			},
+			want: `{"parameters": [
+				{"name": "page", "in": "query", "schema": {"type": "integer", "minimum": 1, "maximum": 10, "multipleOf": 2}},
+				{"name": "X-Request-ID", "in": "header", "required": true, "schema": {"type": "string", "format": "uuid"}},
+				{"name": "slug", "in": "path", "required": true, "schema": {"type": "string", "pattern": "^[a-z-]+$"}},
+				{"name": "theme", "in": "cookie", "schema": {"type": "string", "enum": ["dark", "light"]}}
+			]}`,
+		},
+		{
+			name: "Nests body fields along their paths.",
+			chains: []ValidationChain{
+				NewBodyChain("user.email", nil).Trim("").Email(nil).Length(&vgo.IsLengthOpts{Min: 3, Max: &maxLen}),
+				NewBodyChain("user.name", nil).Not().Empty(nil).Not().Email(nil),
+				NewBodyChain("user.bio", nil).Optional().Length(&vgo.IsLengthOpts{Max: &maxLen}),
+				NewBodyChain("items", nil).ItemCount(&ItemCountOpts{Min: 1}).UniqueItems(),
+				NewBodyChain("items.*.sku", nil).Alphanumeric(nil),
+				NewBodyChain("items.*.qty", nil).Float(nil),
+				NewBodyChain("tags.**", nil).Alpha(nil),
+			},
+			want: `{"requestBody": {"required": true, "content": {"application/json": {"schema": {
+				"type": "object",
+				"required": ["user", "items"],
+				"properties": {
+					"user": {"type": "object", "required": ["email", "name"], "properties": {
+						"email": {"type": "string", "format": "email", "minLength": 3, "maxLength": 64},
+						"name": {"type": "string", "minLength": 1},
+						"bio": {"type": "string", "maxLength": 64}
+					}},
+					"items": {"type": "array", "minItems": 1, "uniqueItems": true, "items": {
+						"type": "object", "required": ["sku", "qty"], "properties": {
+							"sku": {"type": "string"},
+							"qty": {"type": "number"}
+						}
+					}}
+				}
+			}}}}}`,
+		},
+		{
+			name: "Documents NewCheck chains in every location, as optional.",
+			chains: []ValidationChain{
+				NewCheck("token", nil, HeaderLocation, BodyLocation).Length(&vgo.IsLengthOpts{Min: 8}),
+			},
+			want: `{
+				"parameters": [{"name": "token", "in": "header", "schema": {"type": "string", "minLength": 8}}],
+				"requestBody": {"content": {"application/json": {"schema": {
+					"type": "object", "properties": {"token": {"type": "string", "minLength": 8}}
+				}}}}
+			}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := jsonOf(t, OpenAPI(test.chains...))
+			if want := decodeJSON(t, test.want); !cmp.Equal(got, want) {
+				t.Errorf("got %v, want %v\n%s", got, want, cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestOpenAPIRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	otherCalls := 0
+	other := gin.WrapF(func(w http.ResponseWriter, r *http.Request) { otherCalls++ })
+
+	engine := gin.New()
+	routes := NewOpenAPIRoutes()
+
+	api := routes.Router(engine.Group("/api", other)).Validate(NewHeaderChain("X-Tenant", nil).Optional().Alpha(nil))
+	api.Validate(NewParamChain("id", nil).Int(nil), NewBodyChain("email", nil).Email(nil)).
+		OneOf([]ValidationChain{NewBodyChain("phone", nil).MobilePhone(nil, nil)}).
+		POST("/users/:id/*rest", RespondOnError(nil), other)
+	api.Group("/files").CheckSchema(Schema{
+		"photos": {In: FileLocation, Build: func(vc ValidationChain) ValidationChain {
+			return vc.ItemCount(&ItemCountOpts{Min: 1, Max: vgo.Int(3)})
+		}},
+		"title": {In: BodyLocation, Optional: true},
+	}).PUT("/", other)
+
+	// Routes registered on other groups are not collected.
+	engine.GET("/other/:id", Validate(NewBodyChain("ignored", nil)), other)
+
+	want := decodeJSON(t, `{
+		"/api/users/{id}/{rest}": {"post": {
+			"parameters": [
+				{"name": "X-Tenant", "in": "header", "schema": {"type": "string"}},
+				{"name": "id", "in": "path", "required": true, "schema": {"type": "integer"}},
+				{"name": "rest", "in": "path", "required": true, "schema": {"type": "string"}}
+			],
+			"requestBody": {"required": true, "content": {"application/json": {"schema": {
+				"type": "object", "required": ["email"], "properties": {"email": {"type": "string", "format": "email"}, "phone": {"type": "string"}}
+			}}}}
+		}},
+		"/api/files/": {"put": {
+			"parameters": [{"name": "X-Tenant", "in": "header", "schema": {"type": "string"}}],
+			"requestBody": {"required": true, "content": {"multipart/form-data": {"schema": {
+				"type": "object", "required": ["photos"], "properties": {
+					"photos": {"type": "array", "minItems": 1, "maxItems": 3, "items": {"type": "string", "format": "binary"}},
+					"title": {"type": "string"}
+				}
+			}}}}
+		}}
+	}`)
+
+	if got := jsonOf(t, routes.Paths()); !cmp.Equal(got, want) {
+		t.Errorf("got %v, want %v\n%s", got, want, cmp.Diff(want, got))
+	}
+
+	if otherCalls != 0 {
+		t.Errorf("got %d calls of the handlers of other packages, want none", otherCalls)
+	}
+
+	// The routes are still registered and validate requests.
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/api/users/abc/x", nil)
+	engine.ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusUnprocessableEntity)
+	}
+}
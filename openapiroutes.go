@@ -0,0 +1,201 @@
+package ginvalidator
+
+import (
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// routeChains are the chains of a middleware an [OpenAPIRouter] added to its routes.
+// The chains of OneOf groups are optional, since no group is required.
+type routeChains struct {
+	chains   []ValidationChain
+	optional bool
+}
+
+// OpenAPIRoutes collects the validation chains of the routes registered through its [OpenAPIRouter]s,
+// to describe them as the paths of an OpenAPI 3.1 document.
+//
+// A route is described with the chains of the middlewares the router added with its [OpenAPIRouter.Validate],
+// [OpenAPIRouter.OneOf], [OpenAPIRouter.CheckSchema], ... methods, which record them, so no handler is ever called to describe a route.
+// Middlewares passed to Gin as handlers, including those of this package, are registered as usual but not described.
+//
+// Example:
+//
+//	routes := ginvalidator.NewOpenAPIRoutes()
+//
+//	api := routes.Router(router.Group("/api"))
+//	api.Validate(
+//	  ginvalidator.NewParamChain("id", nil).Int(nil),
+//	  ginvalidator.NewBodyChain("email", nil).Email(nil),
+//	).POST("/users/:id", handler)
+//
+//	spec := map[string]any{
+//	  "openapi": "3.1.0",
+//	  "info":    map[string]any{"title": "API", "version": "1.0.0"},
+//	  "paths":   routes.Paths(),
+//	}
+type OpenAPIRoutes struct {
+	mu    sync.Mutex
+	paths map[string]map[string]OpenAPIOperation
+}
+
+// NewOpenAPIRoutes returns an OpenAPIRoutes without routes.
+func NewOpenAPIRoutes() *OpenAPIRoutes {
+	return &OpenAPIRoutes{paths: make(map[string]map[string]OpenAPIOperation)}
+}
+
+// Router returns an OpenAPIRouter registering routes on the group, e.g. &engine.RouterGroup or engine.Group("/api").
+func (o *OpenAPIRoutes) Router(group *gin.RouterGroup) *OpenAPIRouter {
+	return &OpenAPIRouter{routes: o, group: group}
+}
+
+// Paths returns the OpenAPI paths object of the routes: the operation of each method, keyed in lower case,
+// of each path, whose Gin parameters (":id" and "*path") are written as OpenAPI templates ("{id}" and "{path}").
+func (o *OpenAPIRoutes) Paths() map[string]map[string]OpenAPIOperation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	paths := make(map[string]map[string]OpenAPIOperation, len(o.paths))
+	for p, operations := range o.paths {
+		paths[p] = make(map[string]OpenAPIOperation, len(operations))
+		for method, op := range operations {
+			paths[p][method] = op
+		}
+	}
+
+	return paths
+}
+
+// add collects the chains of the middlewares of a route.
+func (o *OpenAPIRoutes) add(method, fullPath string, middlewares []routeChains) {
+	builder := newOpenAPIBuilder()
+
+	for _, recorded := range middlewares {
+		for _, chain := range recorded.chains {
+			builder.addChain(chain, recorded.optional)
+		}
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(fullPath, "/") {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			// A path parameter without a chain is still a required parameter of the path.
+			builder.parameter(ParamLocation, segment[1:], true)
+			segment = "{" + segment[1:] + "}"
+		}
+		segments = append(segments, segment)
+	}
+	openAPIPath := strings.Join(segments, "/")
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.paths[openAPIPath] == nil {
+		o.paths[openAPIPath] = make(map[string]OpenAPIOperation)
+	}
+	o.paths[openAPIPath][strings.ToLower(method)] = builder.operation()
+}
+
+// OpenAPIRouter registers routes on a Gin router group and collects their chains into its [OpenAPIRoutes].
+type OpenAPIRouter struct {
+	routes *OpenAPIRoutes
+	group  *gin.RouterGroup
+
+	// middlewares are the chains of the middlewares added to the group by the router, in order.
+	middlewares []routeChains
+}
+
+// Group creates a router group like [gin.RouterGroup.Group], whose routes are collected as well.
+func (r *OpenAPIRouter) Group(relativePath string, handlers ...gin.HandlerFunc) *OpenAPIRouter {
+	return &OpenAPIRouter{routes: r.routes, group: r.group.Group(relativePath, handlers...), middlewares: r.middlewares}
+}
+
+// Use adds middlewares to the group like [gin.RouterGroup.Use], for the routes registered afterwards.
+// They are not described; add the middlewares of this package with the methods of the router instead.
+func (r *OpenAPIRouter) Use(middleware ...gin.HandlerFunc) *OpenAPIRouter {
+	r.group.Use(middleware...)
+	return r
+}
+
+// Validate returns a router whose routes are validated by [Validate] with the chains ahead of their handlers,
+// and described with them.
+func (r *OpenAPIRouter) Validate(chains ...ValidationChain) *OpenAPIRouter {
+	return r.with(Validate(chains...), routeChains{chains: chains})
+}
+
+// ValidateWith is like [OpenAPIRouter.Validate], with the middleware of [ValidateWith].
+func (r *OpenAPIRouter) ValidateWith(opts *ValidateOpts, chains ...ValidationChain) *OpenAPIRouter {
+	return r.with(ValidateWith(opts, chains...), routeChains{chains: chains})
+}
+
+// OneOf is like [OpenAPIRouter.Validate], with the middleware of [OneOf]. No field of its groups is required.
+func (r *OpenAPIRouter) OneOf(chainGroups ...[]ValidationChain) *OpenAPIRouter {
+	return r.with(OneOf(chainGroups...), routeChains{chains: slices.Concat(chainGroups...), optional: true})
+}
+
+// CheckExact is like [OpenAPIRouter.Validate], with the middleware of [CheckExact].
+func (r *OpenAPIRouter) CheckExact(chains ...ValidationChain) *OpenAPIRouter {
+	return r.with(CheckExact(chains...), routeChains{chains: chains})
+}
+
+// CheckSchema is like [OpenAPIRouter.Validate], with the middleware of [CheckSchema].
+func (r *OpenAPIRouter) CheckSchema(schema Schema) *OpenAPIRouter {
+	return r.with(CheckSchema(schema), routeChains{chains: schema.chains()})
+}
+
+// CheckSchemaExact is like [OpenAPIRouter.Validate], with the middleware of [CheckSchemaExact].
+func (r *OpenAPIRouter) CheckSchemaExact(schema Schema) *OpenAPIRouter {
+	return r.with(CheckSchemaExact(schema), routeChains{chains: schema.chains()})
+}
+
+// with returns a router for a group at the same path, with the middleware and its chains added.
+func (r *OpenAPIRouter) with(middleware gin.HandlerFunc, recorded routeChains) *OpenAPIRouter {
+	return &OpenAPIRouter{
+		routes:      r.routes,
+		group:       r.group.Group("", middleware),
+		middlewares: append(slices.Clip(r.middlewares), recorded),
+	}
+}
+
+// Handle registers a route like [gin.RouterGroup.Handle] and collects its chains.
+func (r *OpenAPIRouter) Handle(method, relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	routes := r.group.Handle(method, relativePath, handlers...)
+
+	fullPath := path.Join(r.group.BasePath(), relativePath)
+	if strings.HasSuffix(relativePath, "/") && !strings.HasSuffix(fullPath, "/") {
+		fullPath += "/"
+	}
+
+	r.routes.add(method, fullPath, r.middlewares)
+	return routes
+}
+
+// GET is a shortcut for Handle(http.MethodGet, relativePath, handlers...).
+func (r *OpenAPIRouter) GET(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodGet, relativePath, handlers...)
+}
+
+// POST is a shortcut for Handle(http.MethodPost, relativePath, handlers...).
+func (r *OpenAPIRouter) POST(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPost, relativePath, handlers...)
+}
+
+// PUT is a shortcut for Handle(http.MethodPut, relativePath, handlers...).
+func (r *OpenAPIRouter) PUT(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPut, relativePath, handlers...)
+}
+
+// PATCH is a shortcut for Handle(http.MethodPatch, relativePath, handlers...).
+func (r *OpenAPIRouter) PATCH(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPatch, relativePath, handlers...)
+}
+
+// DELETE is a shortcut for Handle(http.MethodDelete, relativePath, handlers...).
+func (r *OpenAPIRouter) DELETE(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodDelete, relativePath, handlers...)
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"testing"
 
+	vgo "github.com/bube054/validatorgo"
 	"github.com/gin-gonic/gin"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -640,3 +641,139 @@ func TestQueryValidationChain(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiValueValidationChain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name    string
+		url     string
+		headers http.Header
+		form    string
+		chain   gin.HandlerFunc
+
+		errs []ValidationChainError
+		md   MatchedData
+	}{
+		{
+			name:  "Validators run against every value.",
+			url:   "/test?tag=go&tag=1x&tag=web",
+			chain: NewQueryChain("tag", nil).Alpha(nil).Validate(),
+			errs:  []ValidationChainError{{Location: "queries", Field: "tag[1]", Value: "1x"}},
+			md:    MatchedData{"queries": MatchedDataFieldValues{"tag": "go", "tag[0]": "go", "tag[1]": "1x", "tag[2]": "web"}},
+		},
+		{
+			name:  "Single value keeps the field name.",
+			url:   "/test?tag=1x",
+			chain: NewQueryChain("tag", nil).Alpha(nil).Validate(),
+			errs:  []ValidationChainError{{Location: "queries", Field: "tag", Value: "1x"}},
+			md:    MatchedData{"queries": MatchedDataFieldValues{"tag": "1x"}},
+		},
+		{
+			name:  "Sanitizers run against every value.",
+			url:   "/test?tag[]=+go+&tag[]=web",
+			chain: NewQueryChain("tag[]", nil).Trim("").Validate(),
+			errs:  []ValidationChainError{},
+			md:    MatchedData{"queries": MatchedDataFieldValues{"tag[]": "go", "tag[0]": "go", "tag[1]": "web"}},
+		},
+		{
+			name:  "Item count within range.",
+			url:   "/test?tag=a&tag=b",
+			chain: NewQueryChain("tag", nil).ItemCount(&ItemCountOpts{Min: 1, Max: vgo.Int(2)}).Validate(),
+			errs:  []ValidationChainError{},
+			md:    MatchedData{"queries": MatchedDataFieldValues{"tag": "a", "tag[0]": "a", "tag[1]": "b"}},
+		},
+		{
+			name:  "Too many items.",
+			url:   "/test?tag=a&tag=b&tag=c",
+			chain: NewQueryChain("tag", nil).ItemCount(&ItemCountOpts{Max: vgo.Int(2)}).Alpha(nil).Validate(),
+			errs:  []ValidationChainError{{Location: "queries", Field: "tag", Value: `["a","b","c"]`}},
+			md:    MatchedData{"queries": MatchedDataFieldValues{"tag": "a", "tag[0]": "a", "tag[1]": "b", "tag[2]": "c"}},
+		},
+		{
+			name:  "Too few items.",
+			url:   "/test",
+			chain: NewQueryChain("tag", nil).ItemCount(&ItemCountOpts{Min: 1}).Validate(),
+			errs:  []ValidationChainError{{Location: "queries", Field: "tag", Value: `[]`}},
+		},
+		{
+			name:  "Optional skips array-level validators of a missing field.",
+			url:   "/test",
+			chain: NewQueryChain("tag", nil).Optional().ItemCount(&ItemCountOpts{Min: 1}).Validate(),
+			errs:  []ValidationChainError{},
+		},
+		{
+			name: "Unique items after sanitization.",
+			url:  "/test?tag=Go&tag=go",
+			chain: NewQueryChain("tag", nil).CustomSanitizer(func(r *http.Request, initialValue, sanitizedValue string) string {
+				return strings.ToLower(sanitizedValue)
+			}).UniqueItems().Validate(),
+			errs: []ValidationChainError{{Location: "queries", Field: "tag", Value: `["Go","go"]`}},
+			md:   MatchedData{"queries": MatchedDataFieldValues{"tag": "go", "tag[0]": "go", "tag[1]": "go"}},
+		},
+		{
+			name:  "Not negates array-level validators.",
+			url:   "/test?tag=a&tag=b",
+			chain: NewQueryChain("tag", nil).Not().UniqueItems().Validate(),
+			errs:  []ValidationChainError{{Location: "queries", Field: "tag", Value: `["a","b"]`}},
+			md:    MatchedData{"queries": MatchedDataFieldValues{"tag": "a", "tag[0]": "a", "tag[1]": "b"}},
+		},
+		{
+			name:  "Bracketed nested field.",
+			url:   "/test?filter[status]=open&filter[status]=x1",
+			chain: NewQueryChain("filter.status", nil).Alpha(nil).Validate(),
+			errs:  []ValidationChainError{{Location: "queries", Field: "filter.status[1]", Value: "x1"}},
+			md:    MatchedData{"queries": MatchedDataFieldValues{"filter.status": "open", "filter.status[0]": "open", "filter.status[1]": "x1"}},
+		},
+		{
+			name:    "Repeated header.",
+			url:     "/test",
+			headers: http.Header{"X-Request-Id": {"12", "ab"}},
+			chain:   NewHeaderChain("X-Request-Id", nil).Numeric(nil).Validate(),
+			errs:    []ValidationChainError{{Location: "headers", Field: "X-Request-Id[1]", Value: "ab"}},
+			md:      MatchedData{"headers": MatchedDataFieldValues{"X-Request-Id": "12", "X-Request-Id[0]": "12", "X-Request-Id[1]": "ab"}},
+		},
+		{
+			name:  "Repeated form field.",
+			url:   "/test",
+			form:  "tag=a&tag=b&tag=a",
+			chain: NewBodyChain("tag", nil).UniqueItems().Validate(),
+			errs:  []ValidationChainError{{Location: "body", Field: "tag", Value: `["a","b","a"]`}},
+			md:    MatchedData{"body": MatchedDataFieldValues{"tag": "a", "tag[0]": "a", "tag[1]": "b", "tag[2]": "a"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var (
+				errs []ValidationChainError
+				md   MatchedData
+			)
+
+			router.POST("/test", test.chain, func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+				md, _ = GetMatchedData(ctx)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, test.url, strings.NewReader(test.form))
+			if test.form != "" {
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			}
+			for key, values := range test.headers {
+				req.Header[key] = values
+			}
+			router.ServeHTTP(w, req)
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.IgnoreFields(ValidationChainError{}, "Message"), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+
+			if !cmp.Equal(md, test.md) {
+				t.Errorf("got matched data %+v, want %+v", md, test.md)
+			}
+		})
+	}
+}
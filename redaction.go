@@ -0,0 +1,149 @@
+package ginvalidator
+
+import (
+	"path"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the value of a sensitive field in validation errors, unless the policy has a Mask.
+const RedactedValue string = "[REDACTED]"
+
+// MaskFunc returns the masked form of a sensitive value, which is never empty.
+type MaskFunc func(value string) string
+
+// RedactionPolicy decides which values are masked in the [ValidationChainError] reported for a field,
+// and in the {value} of translated messages, so that secrets are not echoed back to clients or written to logs.
+//
+// A value is masked when its chain is marked with [modifier.Sensitive], when the field matches one of Fields
+// or when its chain contains one of Validators. Matched data is never masked.
+type RedactionPolicy struct {
+	// Fields are patterns of the fields to mask, in the syntax of [path.Match] and matched case-insensitively,
+	// e.g. "*password*" matches "password" and "user.password_confirmation".
+	Fields []string
+
+	// Validators are the names of the validators whose fields are masked, e.g. CreditCardValidatorName.
+	// The value is masked whichever validator of the chain fails.
+	Validators []string
+
+	// Mask masks a value, e.g. [MaskAllButLast](4). When nil, values are replaced with [RedactedValue].
+	Mask MaskFunc
+}
+
+var (
+	redactionPolicyMu sync.RWMutex
+	// redactionPolicy is empty until SetRedactionPolicy is called, so only the values of Sensitive chains are masked.
+	redactionPolicy RedactionPolicy
+)
+
+// RecommendedRedactionPolicy returns a policy masking passwords, secrets, tokens, API keys and the Authorization
+// and Cookie headers, and the values checked by the CreditCard, IBAN, PassportNumber, StrongPassword and JWT validators.
+// It is not applied unless it is passed to [SetRedactionPolicy]:
+//
+//	policy := ginvalidator.RecommendedRedactionPolicy()
+//	policy.Fields = append(policy.Fields, "ssn")
+//	ginvalidator.SetRedactionPolicy(policy)
+func RecommendedRedactionPolicy() RedactionPolicy {
+	return RedactionPolicy{
+		Fields: []string{"*password*", "*secret*", "*token*", "*api_key*", "*apikey*", "authorization", "cookie"},
+		Validators: []string{
+			CreditCardValidatorName,
+			IBANValidatorName,
+			PassportNumberValidatorName,
+			StrongPasswordValidatorName,
+			JWTValidatorName,
+		},
+	}
+}
+
+// SetRedactionPolicy replaces the redaction policy of every chain.
+// The policy is empty by default, masking only the values of chains marked as Sensitive; set an empty RedactionPolicy to go back to it.
+func SetRedactionPolicy(policy RedactionPolicy) {
+	redactionPolicyMu.Lock()
+	defer redactionPolicyMu.Unlock()
+
+	redactionPolicy = policy
+}
+
+// MaskAllButLast returns a MaskFunc that replaces every character but the last n with "*", e.g. "************1234".
+// Values of at most 2n characters are fully masked, so short secrets are never mostly revealed.
+func MaskAllButLast(n int) MaskFunc {
+	return func(value string) string {
+		runes := []rune(value)
+
+		keep := n
+		if len(runes) <= 2*n {
+			keep = 0
+		}
+
+		return strings.Repeat("*", len(runes)-keep) + string(runes[len(runes)-keep:])
+	}
+}
+
+// currentRedactionPolicy returns the redaction policy set with SetRedactionPolicy.
+func currentRedactionPolicy() RedactionPolicy {
+	redactionPolicyMu.RLock()
+	defer redactionPolicyMu.RUnlock()
+
+	return redactionPolicy
+}
+
+// reportedValue returns the value reported in the errors of a field of the chain, masked when it is sensitive.
+func (v ValidationChain) reportedValue(field, value string) string {
+	policy := currentRedactionPolicy()
+
+	return policy.redact(value, v.isSensitive(policy) || policy.matchesField(field) || policy.matchesField(v.validator.field))
+}
+
+// reportedFieldValue returns the value reported in the error of a field that no chain declares, e.g. an unknown field,
+// masked when its name matches the policy.
+func reportedFieldValue(field, value string) string {
+	policy := currentRedactionPolicy()
+
+	return policy.redact(value, policy.matchesField(field))
+}
+
+// isSensitive reports whether the chain is marked as Sensitive or contains a validator masked by the policy.
+func (v ValidationChain) isSensitive(policy RedactionPolicy) bool {
+	for _, descriptor := range v.validator.ruleDescriptors {
+		if descriptor.chainType == modifierType && descriptor.name == SensitiveModifierName {
+			return true
+		}
+
+		if descriptor.chainType == validatorType {
+			for _, name := range policy.Validators {
+				if descriptor.name == name {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesField reports whether a field matches one of the field patterns of the policy.
+func (policy RedactionPolicy) matchesField(field string) bool {
+	field = strings.ToLower(field)
+
+	for _, pattern := range policy.Fields {
+		if ok, _ := path.Match(strings.ToLower(pattern), field); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redact masks a sensitive value with the policy's MaskFunc. Empty values are reported as they are.
+func (policy RedactionPolicy) redact(value string, sensitive bool) string {
+	if !sensitive || value == "" {
+		return value
+	}
+
+	if policy.Mask == nil {
+		return RedactedValue
+	}
+
+	return policy.Mask(value)
+}
@@ -0,0 +1,169 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+)
+
+// resetRedactionPolicy restores the empty default redaction policy once the test is done.
+func resetRedactionPolicy(t *testing.T) {
+	t.Cleanup(func() {
+		SetRedactionPolicy(RedactionPolicy{})
+	})
+}
+
+func TestRedaction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tooShort := &vgo.IsLengthOpts{Min: 10}
+	recommended := RecommendedRedactionPolicy()
+
+	tests := []struct {
+		name    string
+		policy  *RedactionPolicy
+		body    string
+		handler gin.HandlerFunc
+
+		values []string
+	}{
+		{
+			name:    "Reports the value of an ordinary field.",
+			body:    `{"name": "Ada"}`,
+			handler: NewBodyChain("name", nil).Length(tooShort).Validate(),
+			values:  []string{"Ada"},
+		},
+		{
+			name:    "Masks the value of a Sensitive chain.",
+			body:    `{"pin": "1234"}`,
+			handler: NewBodyChain("pin", nil).Length(tooShort).Sensitive().Validate(),
+			values:  []string{RedactedValue},
+		},
+		{
+			name:    "Reports the value of a field matching the recommended patterns without a policy.",
+			body:    `{"user": {"Password": "hunter2"}}`,
+			handler: NewBodyChain("user.Password", nil).Length(tooShort).Validate(),
+			values:  []string{"hunter2"},
+		},
+		{
+			name:    "Masks fields matching the recommended patterns.",
+			policy:  &recommended,
+			body:    `{"user": {"Password": "hunter2"}}`,
+			handler: NewBodyChain("user.Password", nil).Length(tooShort).Validate(),
+			values:  []string{RedactedValue},
+		},
+		{
+			name:    "Masks the value of a chain with a redacted validator, whichever validator fails.",
+			policy:  &recommended,
+			body:    `{"card": "4111"}`,
+			handler: NewBodyChain("card", nil).Length(tooShort).Bail().CreditCard(nil).Validate(),
+			values:  []string{RedactedValue},
+		},
+		{
+			name:    "Reports an empty value as it is.",
+			policy:  &recommended,
+			body:    `{"password": ""}`,
+			handler: NewBodyChain("password", nil).Length(tooShort).Validate(),
+			values:  []string{""},
+		},
+		{
+			name:    "Masks the fields of a custom policy.",
+			policy:  &RedactionPolicy{Fields: []string{"ssn"}, Mask: MaskAllButLast(4)},
+			body:    `{"ssn": "078-05-1120x", "password": "hunter2"}`,
+			handler: CheckExact(NewBodyChain("ssn", nil).Length(&vgo.IsLengthOpts{Min: 20}), NewBodyChain("password", nil).Length(tooShort)),
+			values:  []string{"********120x", "hunter2"},
+		},
+		{
+			name:    "Masks unknown fields matching the policy.",
+			policy:  &recommended,
+			body:    `{"name": "Ada", "api_key": "k3y"}`,
+			handler: CheckExact(NewBodyChain("name", nil)),
+			values:  []string{RedactedValue},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resetRedactionPolicy(t)
+			if test.policy != nil {
+				SetRedactionPolicy(*test.policy)
+			}
+
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var errs []ValidationChainError
+
+			router.POST("/test", test.handler, func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+			})
+
+			req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			values := make([]string, len(errs))
+			for i, err := range errs {
+				values[i] = err.Value
+			}
+
+			if !cmp.Equal(values, test.values) {
+				t.Errorf("got values %q, want %q", values, test.values)
+			}
+		})
+	}
+}
+
+func TestRedactionInTranslatedMessages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	resetMessageCatalogs(t)
+
+	RegisterMessageCatalog("en", MessageCatalog{"Length": "{field} is too short: {value}"})
+	SetDefaultLocale("en")
+
+	resetRedactionPolicy(t)
+	SetRedactionPolicy(RecommendedRedactionPolicy())
+
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	var errs []ValidationChainError
+
+	router.POST("/test", NewBodyChain("password", nil).Length(&vgo.IsLengthOpts{Min: 10}).Validate(), func(ctx *gin.Context) {
+		errs, _ = ValidationResult(ctx)
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(`{"password": "hunter2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	want := "password is too short: " + RedactedValue
+	if len(errs) != 1 || errs[0].Message != want {
+		t.Errorf("got errors %+v, want the message %q", errs, want)
+	}
+}
+
+func TestMaskAllButLast(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "4111111111111111", want: "************1111"},
+		{value: "12345678", want: "********"},
+		{value: "123456789", want: "*****6789"},
+		{value: "ünïcödé-pässwörd", want: "************wörd"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if got := MaskAllButLast(4)(test.value); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
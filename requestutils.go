@@ -1,10 +1,8 @@
 package ginvalidator
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
-	"io"
 	"log"
 	"strings"
 
@@ -24,6 +22,21 @@ var (
 
 	// ErrExtractionInvalidJSON occurs when JSON parsing fails due to malformed JSON in the request body.
 	ErrExtractionInvalidJSON = errors.New("failed to extract field: invalid JSON in request body")
+
+	// ErrExtractionInvalidBody occurs when the body decoder registered for the Content-Type fails to decode the request body.
+	ErrExtractionInvalidBody = errors.New("failed to extract field: malformed request body")
+)
+
+// Error codes of the validation errors reported when a field cannot be extracted from the request.
+const (
+	// UnsupportedContentTypeCode is the code of the error reported when no body decoder is registered for the Content-Type.
+	UnsupportedContentTypeCode string = "unsupported_content_type"
+
+	// InvalidBodyCode is the code of the error reported when the request body cannot be decoded.
+	InvalidBodyCode string = "invalid_body"
+
+	// ExtractionFailedCode is the code of the error reported when a field cannot be extracted for any other reason.
+	ExtractionFailedCode string = "extraction_failed"
 )
 
 // RequestLocation defines different locations where data can be extracted from the request.
@@ -45,11 +58,86 @@ const (
 
 	// QueryLocation represents query parameters in the URL of the request.
 	QueryLocation
+
+	// FileLocation represents the files uploaded with a "multipart/form-data" body.
+	FileLocation
 )
 
 // String returns a string representation of the RequestLocation.
 func (l RequestLocation) String() string {
-	return [...]string{"body", "cookies", "headers", "params", "queries"}[l]
+	return [...]string{"body", "cookies", "headers", "params", "queries", "files"}[l]
+}
+
+// parseRequestLocation returns the RequestLocation named by its String form (e.g. "queries") or its singular (e.g. "query").
+func parseRequestLocation(name string) (RequestLocation, bool) {
+	switch strings.ToLower(name) {
+	case "body":
+		return BodyLocation, true
+	case "cookies", "cookie":
+		return CookieLocation, true
+	case "headers", "header":
+		return HeaderLocation, true
+	case "params", "param":
+		return ParamLocation, true
+	case "queries", "query":
+		return QueryLocation, true
+	case "files", "file":
+		return FileLocation, true
+	default:
+		return 0, false
+	}
+}
+
+// valueKind describes how a field was sent, so that a missing field can be told apart from an empty value.
+type valueKind int
+
+const (
+	// stringValue is a text value, which every cookie, header, param, query and form value is.
+	stringValue valueKind = iota
+
+	// missingValue is the kind of a field that is not in the request.
+	missingValue
+
+	// nullValue is a JSON null.
+	nullValue
+
+	// numberValue is a JSON number.
+	numberValue
+
+	// boolValue is a JSON true or false.
+	boolValue
+
+	// objectValue is a JSON object or array.
+	objectValue
+)
+
+// jsonValueKind returns the kind of a value of a JSON body.
+func jsonValueKind(result gjson.Result) valueKind {
+	if !result.Exists() {
+		return missingValue
+	}
+
+	switch result.Type {
+	case gjson.Null:
+		return nullValue
+	case gjson.Number:
+		return numberValue
+	case gjson.True, gjson.False:
+		return boolValue
+	case gjson.JSON:
+		return objectValue
+	default:
+		return stringValue
+	}
+}
+
+// valuesKind returns the kind of a field that can be sent several times: missing when it was not sent at all.
+func valuesKind(values []string) valueKind {
+	if len(values) == 0 {
+		return missingValue
+	}
+
+	return stringValue
 }
 
 type validationChainType int
@@ -60,95 +148,224 @@ const (
 	modifierType
 )
 
-func extractFieldValFromBody(ctx *gin.Context, field string) (string, error) {
-	if ctx == nil {
-		return "", ErrFieldExtractionFromNilCtx
+// extractionErrCode returns the error code reported for an error returned while extracting a field.
+func extractionErrCode(err error) string {
+	switch {
+	case errors.Is(err, ErrExtractionInvalidContentType):
+		return UnsupportedContentTypeCode
+	case errors.Is(err, ErrExtractionInvalidBody), errors.Is(err, ErrExtractionInvalidJSON):
+		return InvalidBodyCode
+	default:
+		return ExtractionFailedCode
+	}
+}
+
+// extractFieldVal returns the value and kind of a field at the given request location.
+// Fields that can be sent several times resolve to their first value.
+func extractFieldVal(ctx *gin.Context, loc RequestLocation, field string) (string, valueKind, error) {
+	switch loc {
+	case BodyLocation:
+		return extractFieldValFromBody(ctx, field)
+	case CookieLocation:
+		return extractFieldValFromCookie(ctx, field)
+	case HeaderLocation:
+		return extractFieldValFromHeader(ctx, field)
+	case ParamLocation:
+		return extractFieldValFromParam(ctx, field)
+	case QueryLocation:
+		return extractFieldValFromQuery(ctx, field)
+	case FileLocation:
+		return extractFieldValFromFiles(ctx, field)
+	default:
+		return "", missingValue, nil
+	}
+}
+
+func extractFieldValFromBody(ctx *gin.Context, field string) (string, valueKind, error) {
+	body, err := getRequestBody(ctx)
+	if err != nil {
+		return "", missingValue, err
+	}
+
+	if body.isForm {
+		values := body.form[field]
+		if len(values) == 0 {
+			return "", missingValue, nil
+		}
+		return values[0], stringValue, nil
+	}
+
+	var result gjson.Result
+	if body.implicitArrays {
+		result = getImplicitArrayPath(body.json, field)
+	} else {
+		result = body.json.Get(field)
 	}
 
-	data, err := ctx.GetRawData()
+	return result.String(), jsonValueKind(result), nil
+}
+
+// extractWildcardFieldValsFromBody expands a field containing "*" or "**" segments against the decoded body
+// and returns the value of every concrete path it matches.
+// Form bodies have no nested values, so the field is looked up as a plain form field name.
+func extractWildcardFieldValsFromBody(ctx *gin.Context, field string) ([]fieldInstance, error) {
+	body, err := getRequestBody(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	ctx.Request.Body = io.NopCloser(bytes.NewBuffer(data))
+	if body.isForm {
+		values := body.form[field]
+		if len(values) == 0 {
+			return []fieldInstance{{field: field, kind: missingValue}}, nil
+		}
+		return []fieldInstance{{field: field, value: values[0]}}, nil
+	}
 
-	contentType := ctx.GetHeader("Content-Type")
+	return expandWildcardField(body.json, field, body.implicitArrays), nil
+}
 
-	if contentType == "application/json" {
-		jsonStr := string(data)
-		result := gjson.Get(jsonStr, field)
-		return result.String(), nil
+// extractFieldValsFromBody returns every value of a form body field, looked up under all the keys of [multiValueKeys].
+// It reports false for bodies that are not forms, whose fields can only have one value.
+func extractFieldValsFromBody(ctx *gin.Context, field string) ([]string, bool, error) {
+	body, err := getRequestBody(ctx)
+	if err != nil {
+		return nil, false, err
 	}
 
-	if contentType == "application/x-www-form-urlencoded" || strings.HasPrefix(contentType, "multipart/form-data") {
-		return ctx.PostForm(field), nil
+	if !body.isForm {
+		return nil, false, nil
 	}
 
-	// Invalid content type
-	return "", fmt.Errorf("%s is %w", contentType, ErrExtractionInvalidContentType)
+	values := []string{}
+	for _, key := range multiValueKeys(field) {
+		values = append(values, body.form[key]...)
+	}
+
+	return values, true, nil
 }
 
-func extractFieldValFromCookie(ctx *gin.Context, field string) (string, error) {
+func extractFieldValFromCookie(ctx *gin.Context, field string) (string, valueKind, error) {
 	if ctx == nil {
-		return "", ErrFieldExtractionFromNilCtx
+		return "", missingValue, ErrFieldExtractionFromNilCtx
 	}
 
 	cookie, err := ctx.Cookie(field)
 
+	if errors.Is(err, http.ErrNoCookie) {
+		return "", missingValue, nil
+	}
+
 	if err != nil {
-		return "", err
+		return "", missingValue, err
+	}
+
+	return cookie, stringValue, nil
+}
+
+func extractFieldValFromHeader(ctx *gin.Context, field string) (string, valueKind, error) {
+	values, err := extractFieldValsFromHeader(ctx, field)
+	if err != nil {
+		return "", missingValue, err
+	}
+
+	if len(values) == 0 {
+		return "", missingValue, nil
 	}
 
-	return cookie, nil
+	return values[0], stringValue, nil
 }
 
-func extractFieldValFromHeader(ctx *gin.Context, field string) (string, error) {
+// extractFieldValsFromHeader returns every value of a header, in the order they were sent.
+func extractFieldValsFromHeader(ctx *gin.Context, field string) ([]string, error) {
 	if ctx == nil {
-		return "", ErrFieldExtractionFromNilCtx
+		return nil, ErrFieldExtractionFromNilCtx
 	}
 
-	header := ctx.GetHeader(field)
+	values := ctx.Request.Header.Values(field)
 
-	if header == "" {
-		return getOriginalHeaderValue(ctx.Request.Header, field), nil
+	if len(values) == 0 {
+		return getOriginalHeaderValues(ctx.Request.Header, field), nil
 	}
 
-	return header, nil
+	return values, nil
 }
 
-func extractFieldValFromParam(ctx *gin.Context, field string) (string, error) {
+func extractFieldValFromParam(ctx *gin.Context, field string) (string, valueKind, error) {
 	if ctx == nil {
-		return "", ErrFieldExtractionFromNilCtx
+		return "", missingValue, ErrFieldExtractionFromNilCtx
 	}
 
-	param := ctx.Param(field)
+	param, ok := ctx.Params.Get(field)
+
+	if !ok {
+		return "", missingValue, nil
+	}
 
 	param, err := url.QueryUnescape(param)
 
 	if err != nil {
-		return "", err
+		return "", missingValue, err
 	}
 
-	return param, nil
+	return param, stringValue, nil
 }
 
-func extractFieldValFromQuery(ctx *gin.Context, field string) (string, error) {
+func extractFieldValFromQuery(ctx *gin.Context, field string) (string, valueKind, error) {
 	if ctx == nil {
-		return "", ErrFieldExtractionFromNilCtx
+		return "", missingValue, ErrFieldExtractionFromNilCtx
+	}
+
+	query, ok := ctx.GetQuery(field)
+
+	if !ok {
+		return "", missingValue, nil
 	}
 
-	query := ctx.Query(field)
+	return query, stringValue, nil
+}
+
+// extractFieldValsFromQuery returns every value of a query field, looked up under all the keys of [multiValueKeys].
+func extractFieldValsFromQuery(ctx *gin.Context, field string) ([]string, error) {
+	if ctx == nil {
+		return nil, ErrFieldExtractionFromNilCtx
+	}
+
+	values := []string{}
+	for _, key := range multiValueKeys(field) {
+		values = append(values, ctx.QueryArray(key)...)
+	}
+
+	return values, nil
+}
+
+// multiValueKeys returns the keys a query or form field can be sent under.
+// Both "tag" and "tag[]" match values sent as "tag=a&tag=b" or "tag[]=a&tag[]=b",
+// and "filter.status" also matches values sent as "filter[status]=open".
+func multiValueKeys(field string) []string {
+	name := strings.TrimSuffix(field, "[]")
+	keys := []string{name, name + "[]"}
+
+	if strings.Contains(name, ".") && !strings.ContainsAny(name, "[]") {
+		segments := strings.Split(name, ".")
+		keys = append(keys, segments[0]+"["+strings.Join(segments[1:], "][")+"]")
+	}
+
+	return keys
+}
 
-	return query, nil
+// indexedField returns the name reported for the value at index i of a multi-value field, e.g. "tag[1]".
+func indexedField(field string, i int) string {
+	return fmt.Sprintf("%s[%d]", strings.TrimSuffix(field, "[]"), i)
 }
 
-func getOriginalHeaderValue(headers http.Header, key string) string {
+func getOriginalHeaderValues(headers http.Header, key string) []string {
 	for k, v := range headers {
 		if strings.EqualFold(k, key) {
 			canonicalKey := http.CanonicalHeaderKey(key)
 			log.Printf("Warning: Non-canonical header key '%s' used. Expected '%s'.", key, canonicalKey)
-			return v[0]
+			return v
 		}
 	}
-	return ""
+	return []string{}
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 
 	"github.com/gin-gonic/gin"
@@ -175,7 +176,7 @@ John%20Doe
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			ctx := createTestGinCtx(test.opts)
-			ans, err := extractFieldValFromBody(ctx, test.field)
+			ans, _, err := extractFieldValFromBody(ctx, test.field)
 
 			if err != nil {
 				if !errors.Is(test.err, test.err) {
@@ -198,13 +199,14 @@ func TestExtractFieldValFromCookie(t *testing.T) {
 		opts  ginCtxReqOpts
 		field string
 
-		value string
-		err   error
+		value   string
+		missing bool
+		err     error
 	}{
 		{name: "Valid cookie extraction", field: "name", opts: ginCtxReqOpts{cookies: []*http.Cookie{{Name: "name", Value: "John"}}}, value: `John`, err: nil},
 		{name: "Valid cookie extraction (multiple cookies)", field: "session", opts: ginCtxReqOpts{cookies: []*http.Cookie{{Name: "session", Value: "abc123"}}}, value: `abc123`, err: nil},
 		{name: "Valid cookie extraction (empty cookie)", field: "empty", opts: ginCtxReqOpts{cookies: []*http.Cookie{{Name: "empty", Value: ""}}}, value: ``, err: nil},
-		{name: "Invalid cookie extraction (missing cookie)", field: "missing", opts: ginCtxReqOpts{cookies: nil}, value: ``, err: nil},
+		{name: "Invalid cookie extraction (missing cookie)", field: "missing", opts: ginCtxReqOpts{cookies: nil}, value: ``, missing: true, err: nil},
 		{name: "Valid cookie extraction (special characters)", field: "name", opts: ginCtxReqOpts{cookies: []*http.Cookie{{Name: "name", Value: "John%20Doe"}}}, value: `John Doe`, err: nil},
 		{name: "Valid cookie extraction (numeric value)", field: "age", opts: ginCtxReqOpts{cookies: []*http.Cookie{{Name: "age", Value: "42"}}}, value: `42`, err: nil},
 	}
@@ -212,7 +214,7 @@ func TestExtractFieldValFromCookie(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			ctx := createTestGinCtx(test.opts)
-			ans, err := extractFieldValFromCookie(ctx, test.field)
+			ans, kind, err := extractFieldValFromCookie(ctx, test.field)
 
 			if err != nil {
 				if !errors.Is(test.err, test.err) {
@@ -223,6 +225,10 @@ func TestExtractFieldValFromCookie(t *testing.T) {
 			if ans != test.value {
 				t.Errorf("got %q, want %q", ans, test.value)
 			}
+
+			if missing := kind == missingValue; missing != test.missing {
+				t.Errorf("got missing %t, want %t", missing, test.missing)
+			}
 		})
 	}
 }
@@ -233,13 +239,14 @@ func TestExtractFieldValFromHeader(t *testing.T) {
 		opts  ginCtxReqOpts
 		field string
 
-		value string
-		err   error
+		value   string
+		missing bool
+		err     error
 	}{
 		{name: "Valid header extraction", field: "name", opts: ginCtxReqOpts{headers: map[string]string{"name": "John"}}, value: `John`, err: nil},
 		{name: "Valid header extraction (multiple headers)", field: "session", opts: ginCtxReqOpts{headers: map[string]string{"session": "abc123"}}, value: `abc123`, err: nil},
 		{name: "Valid header extraction (empty header)", field: "empty", opts: ginCtxReqOpts{headers: map[string]string{"empty": ""}}, value: ``, err: nil},
-		{name: "Invalid header extraction (missing header)", field: "missing", opts: ginCtxReqOpts{headers: map[string]string{}}, value: ``, err: nil},
+		{name: "Invalid header extraction (missing header)", field: "missing", opts: ginCtxReqOpts{headers: map[string]string{}}, value: ``, missing: true, err: nil},
 		{name: "Valid header extraction (special characters)", field: "name", opts: ginCtxReqOpts{headers: map[string]string{"name": "John%20Doe"}}, value: `John%20Doe`, err: nil},
 		{name: "Valid header extraction (numeric value)", field: "age", opts: ginCtxReqOpts{headers: map[string]string{"age": "42"}}, value: `42`, err: nil},
 	}
@@ -247,7 +254,7 @@ func TestExtractFieldValFromHeader(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			ctx := createTestGinCtx(test.opts)
-			ans, err := extractFieldValFromHeader(ctx, test.field)
+			ans, kind, err := extractFieldValFromHeader(ctx, test.field)
 
 			if err != nil {
 				if !errors.Is(test.err, test.err) {
@@ -258,6 +265,10 @@ func TestExtractFieldValFromHeader(t *testing.T) {
 			if ans != test.value {
 				t.Errorf("got %q, want %q", ans, test.value)
 			}
+
+			if missing := kind == missingValue; missing != test.missing {
+				t.Errorf("got missing %t, want %t", missing, test.missing)
+			}
 		})
 	}
 }
@@ -268,13 +279,14 @@ func TestExtractFieldValFromParam(t *testing.T) {
 		opts  ginCtxReqOpts
 		field string
 
-		value string
-		err   error
+		value   string
+		missing bool
+		err     error
 	}{
 		{name: "Valid header extraction", field: "name", opts: ginCtxReqOpts{headers: map[string]string{"name": "John"}, params: gin.Params{gin.Param{Key: "name", Value: "John"}}}, value: `John`, err: nil},
 		{name: "Valid header extraction (multiple headers)", field: "session", opts: ginCtxReqOpts{headers: map[string]string{"session": "abc123"}, params: gin.Params{gin.Param{Key: "session", Value: "abc123"}}}, value: `abc123`, err: nil},
 		{name: "Valid header extraction (empty header)", field: "empty", opts: ginCtxReqOpts{headers: map[string]string{"empty": ""}, params: gin.Params{gin.Param{Key: "empty", Value: ""}}}, value: ``, err: nil},
-		{name: "Invalid header extraction (missing header)", field: "missing", opts: ginCtxReqOpts{headers: map[string]string{}, params: gin.Params{}}, value: ``, err: nil},
+		{name: "Invalid header extraction (missing header)", field: "missing", opts: ginCtxReqOpts{headers: map[string]string{}, params: gin.Params{}}, value: ``, missing: true, err: nil},
 		{name: "Valid header extraction (special characters)", field: "name", opts: ginCtxReqOpts{headers: map[string]string{"name": "John%20Doe"}, params: gin.Params{gin.Param{Key: "name", Value: "John%20Doe"}}}, value: `John Doe`, err: nil},
 		{name: "Valid header extraction (numeric value)", field: "age", opts: ginCtxReqOpts{headers: map[string]string{"age": "42"}, params: gin.Params{gin.Param{Key: "age", Value: "42"}}}, value: `42`, err: nil},
 	}
@@ -282,7 +294,7 @@ func TestExtractFieldValFromParam(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			ctx := createTestGinCtx(test.opts)
-			ans, err := extractFieldValFromParam(ctx, test.field)
+			ans, kind, err := extractFieldValFromParam(ctx, test.field)
 
 			if err != nil {
 				if !errors.Is(test.err, test.err) {
@@ -293,6 +305,10 @@ func TestExtractFieldValFromParam(t *testing.T) {
 			if ans != test.value {
 				t.Errorf("got %q, want %q", ans, test.value)
 			}
+
+			if missing := kind == missingValue; missing != test.missing {
+				t.Errorf("got missing %t, want %t", missing, test.missing)
+			}
 		})
 	}
 }
@@ -303,13 +319,14 @@ func TestExtractFieldValFromQuery(t *testing.T) {
 		opts  ginCtxReqOpts
 		field string
 
-		value string
-		err   error
+		value   string
+		missing bool
+		err     error
 	}{
 		{name: "Valid query extraction", field: "name", opts: ginCtxReqOpts{url: "/test?name=John"}, value: `John`, err: nil},
 		{name: "Valid query extraction (multiple queries)", field: "session", opts: ginCtxReqOpts{url: "/test?session=abc123"}, value: `abc123`, err: nil},
 		{name: "Valid query extraction (empty query)", field: "empty", opts: ginCtxReqOpts{url: "/test?empty="}, value: ``, err: nil},
-		{name: "Invalid query extraction (missing query)", field: "missing", opts: ginCtxReqOpts{url: "/test"}, value: ``, err: nil},
+		{name: "Invalid query extraction (missing query)", field: "missing", opts: ginCtxReqOpts{url: "/test"}, value: ``, missing: true, err: nil},
 		{name: "Valid query extraction (special characters)", field: "name", opts: ginCtxReqOpts{url: "/test?name=John%20Doe"}, value: `John Doe`, err: nil},
 		{name: "Valid query extraction (numeric value)", field: "age", opts: ginCtxReqOpts{url: "/test?age=42"}, value: `42`, err: nil},
 	}
@@ -317,7 +334,7 @@ func TestExtractFieldValFromQuery(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			ctx := createTestGinCtx(test.opts)
-			ans, err := extractFieldValFromQuery(ctx, test.field)
+			ans, kind, err := extractFieldValFromQuery(ctx, test.field)
 
 			if err != nil {
 				if !errors.Is(test.err, test.err) {
@@ -328,6 +345,64 @@ func TestExtractFieldValFromQuery(t *testing.T) {
 			if ans != test.value {
 				t.Errorf("got %q, want %q", ans, test.value)
 			}
+
+			if missing := kind == missingValue; missing != test.missing {
+				t.Errorf("got missing %t, want %t", missing, test.missing)
+			}
+		})
+	}
+}
+
+func TestMultiValueKeys(t *testing.T) {
+	tests := []struct {
+		field string
+		want  []string
+	}{
+		{field: "tag", want: []string{"tag", "tag[]"}},
+		{field: "tag[]", want: []string{"tag", "tag[]"}},
+		{field: "filter[status]", want: []string{"filter[status]", "filter[status][]"}},
+		{field: "filter.status", want: []string{"filter.status", "filter.status[]", "filter[status]"}},
+		{field: "a.b.c", want: []string{"a.b.c", "a.b.c[]", "a[b][c]"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.field, func(t *testing.T) {
+			got := multiValueKeys(test.field)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExtractFieldValsFromQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		url   string
+		field string
+
+		values []string
+	}{
+		{name: "Repeated query", url: "/test?tag=a&tag=b", field: "tag", values: []string{"a", "b"}},
+		{name: "Bracketed query", url: "/test?tag[]=a&tag[]=b", field: "tag", values: []string{"a", "b"}},
+		{name: "Bracketed field", url: "/test?tag[]=a&tag=b", field: "tag[]", values: []string{"b", "a"}},
+		{name: "Nested bracketed query", url: "/test?filter[status]=open", field: "filter.status", values: []string{"open"}},
+		{name: "Missing query", url: "/test", field: "tag", values: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := createTestGinCtx(ginCtxReqOpts{url: test.url})
+			values, err := extractFieldValsFromQuery(ctx, test.field)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(values, test.values) {
+				t.Errorf("got %v, want %v", values, test.values)
+			}
 		})
 	}
 }
@@ -0,0 +1,221 @@
+package ginvalidator
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Content types of the error responses rendered by the renderers of this package.
+const (
+	// ProblemJSONContentType is the content type of RFC 9457 (formerly RFC 7807) problem details.
+	ProblemJSONContentType string = "application/problem+json"
+
+	// JSONAPIContentType is the content type of JSON:API documents.
+	JSONAPIContentType string = "application/vnd.api+json"
+)
+
+// ErrorRenderer writes the response of a request that failed validation.
+// It receives the validation errors in the order they occurred and the status to respond with.
+type ErrorRenderer func(ctx *gin.Context, status int, errs []ValidationChainError)
+
+// RespondOpts configures the response of [RespondOnError].
+type RespondOpts struct {
+	Status   int           // the status of the response, 422 Unprocessable Entity when zero
+	Renderer ErrorRenderer // the renderer of the response, RenderProblemDetails when nil
+}
+
+// RespondOnError is a middleware that aborts the request with an error response when an earlier validation chain failed,
+// so handlers no longer have to check [HasErrors] themselves. A request without validation errors passes through.
+//
+// The response is rendered by opts.Renderer, e.g. [RenderProblemDetails] (the default), [RenderJSONAPI] or [RenderFieldMap].
+//
+// Example:
+//
+//	router.POST("/users",
+//	  ginvalidator.NewBodyChain("email", nil).Email(nil).Validate(),
+//	  ginvalidator.RespondOnError(nil),
+//	  handler,
+//	)
+func RespondOnError(opts *RespondOpts) gin.HandlerFunc {
+	status := http.StatusUnprocessableEntity
+	renderer := RenderProblemDetails
+
+	if opts != nil {
+		if opts.Status != 0 {
+			status = opts.Status
+		}
+		if opts.Renderer != nil {
+			renderer = opts.Renderer
+		}
+	}
+
+	return func(ctx *gin.Context) {
+		errs, err := ValidationResult(ctx)
+		if err != nil || len(errs) == 0 {
+			ctx.Next()
+			return
+		}
+
+		ctx.Abort()
+		renderer(ctx, status, errs)
+	}
+}
+
+// ProblemDetails is an RFC 9457 problem details object describing a request that failed validation.
+type ProblemDetails struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params"`
+}
+
+// InvalidParam is an entry of the "invalid-params" extension member of [ProblemDetails], one per validation error.
+type InvalidParam struct {
+	Name     string `json:"name"`
+	Reason   string `json:"reason"`
+	Location string `json:"location"`
+	Code     string `json:"code,omitempty"`
+}
+
+// RenderProblemDetails renders the validation errors as application/problem+json [ProblemDetails] of the "about:blank" type,
+// whose title is the status text.
+func RenderProblemDetails(ctx *gin.Context, status int, errs []ValidationChainError) {
+	NewProblemDetailsRenderer("about:blank", "")(ctx, status, errs)
+}
+
+// NewProblemDetailsRenderer returns a renderer like [RenderProblemDetails] with the given problem type URI and title,
+// e.g. "https://example.com/probs/validation" and "Your request is not valid.". An empty title is the status text.
+func NewProblemDetailsRenderer(typeURI, title string) ErrorRenderer {
+	return func(ctx *gin.Context, status int, errs []ValidationChainError) {
+		problem := ProblemDetails{
+			Type:          typeURI,
+			Title:         title,
+			Status:        status,
+			Detail:        problemDetail(len(errs)),
+			InvalidParams: make([]InvalidParam, len(errs)),
+		}
+
+		if problem.Title == "" {
+			problem.Title = http.StatusText(status)
+		}
+
+		for i, err := range errs {
+			problem.InvalidParams[i] = InvalidParam{Name: err.Field, Reason: err.Message, Location: err.Location, Code: err.Code}
+		}
+
+		ctx.Header("Content-Type", ProblemJSONContentType)
+		ctx.JSON(status, problem)
+	}
+}
+
+// problemDetail returns the detail member of the problem details of a request with n invalid parameters.
+func problemDetail(n int) string {
+	if n == 1 {
+		return "The request has 1 invalid parameter."
+	}
+
+	return fmt.Sprintf("The request has %d invalid parameters.", n)
+}
+
+// JSONAPIError is a JSON:API error object describing a validation error.
+type JSONAPIError struct {
+	Status string              `json:"status"`
+	Code   string              `json:"code,omitempty"`
+	Title  string              `json:"title"`
+	Detail string              `json:"detail"`
+	Source *JSONAPIErrorSource `json:"source,omitempty"`
+	Meta   map[string]string   `json:"meta"`
+}
+
+// JSONAPIErrorSource is the source member of a [JSONAPIError], naming the part of the request that caused it.
+type JSONAPIErrorSource struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+	Header    string `json:"header,omitempty"`
+}
+
+// RenderJSONAPI renders the validation errors as a JSON:API document with one error object per validation error.
+//
+// The source of an error is a JSON pointer for a body field (e.g. "/items/1/sku" for "items[1].sku"),
+// the parameter for a query field and the header for a header field. Cookies and path params have no source,
+// and every error object names its location and field in its meta member.
+func RenderJSONAPI(ctx *gin.Context, status int, errs []ValidationChainError) {
+	objects := make([]JSONAPIError, len(errs))
+
+	for i, err := range errs {
+		objects[i] = JSONAPIError{
+			Status: strconv.Itoa(status),
+			Code:   err.Code,
+			Title:  http.StatusText(status),
+			Detail: err.Message,
+			Source: jsonAPIErrorSource(err),
+			Meta:   map[string]string{"location": err.Location, "field": err.Field},
+		}
+	}
+
+	ctx.Header("Content-Type", JSONAPIContentType)
+	ctx.JSON(status, gin.H{"errors": objects})
+}
+
+// jsonAPIErrorSource returns the source of the JSON:API error object of a validation error, or nil.
+func jsonAPIErrorSource(err ValidationChainError) *JSONAPIErrorSource {
+	switch err.Location {
+	case BodyLocation.String():
+		return &JSONAPIErrorSource{Pointer: jsonPointer(err.Field)}
+	case QueryLocation.String():
+		return &JSONAPIErrorSource{Parameter: err.Field}
+	case HeaderLocation.String():
+		return &JSONAPIErrorSource{Header: err.Field}
+	default:
+		return nil
+	}
+}
+
+// jsonPointer converts a body field into an RFC 6901 JSON pointer, e.g. "items[1].sku" into "/items/1/sku".
+func jsonPointer(field string) string {
+	var pointer, token strings.Builder
+
+	flush := func() {
+		pointer.WriteByte('/')
+		pointer.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token.String()))
+		token.Reset()
+	}
+
+	for i := 0; i < len(field); i++ {
+		switch c := field[i]; {
+		case c == '\\' && i+1 < len(field):
+			i++
+			token.WriteByte(field[i])
+		case c == '.':
+			flush()
+		case c == '[' && token.Len() > 0:
+			flush()
+		case c == '[' || c == ']':
+		default:
+			token.WriteByte(c)
+		}
+	}
+
+	if token.Len() > 0 || pointer.Len() == 0 {
+		flush()
+	}
+
+	return pointer.String()
+}
+
+// RenderFieldMap renders the validation errors as a JSON object of their messages by field,
+// e.g. {"errors": {"email": ["Invalid value"]}}, in the same way as [ErrorsByField] groups them.
+func RenderFieldMap(ctx *gin.Context, status int, errs []ValidationChainError) {
+	messages := make(map[string][]string)
+
+	for _, err := range errs {
+		messages[err.Field] = append(messages[err.Field], err.Message)
+	}
+
+	ctx.JSON(status, gin.H{"errors": messages})
+}
@@ -0,0 +1,149 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRespondOnError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name string
+		url  string
+		body string
+		opts *RespondOpts
+
+		status      int
+		contentType string
+		response    string
+	}{
+		{
+			name:        "Passes a valid request through.",
+			url:         "/test?page=1",
+			body:        `{"email": "a@b.com"}`,
+			status:      http.StatusOK,
+			contentType: "text/plain; charset=utf-8",
+			response:    `"handled"`,
+		},
+		{
+			name:        "Renders problem details by default.",
+			url:         "/test?page=x",
+			body:        `{}`,
+			status:      http.StatusUnprocessableEntity,
+			contentType: ProblemJSONContentType,
+			response: `{
+				"type": "about:blank",
+				"title": "Unprocessable Entity",
+				"status": 422,
+				"detail": "The request has 2 invalid parameters.",
+				"invalid-params": [
+					{"name": "email", "reason": "email is required", "location": "body", "code": "required"},
+					{"name": "page", "reason": "page must be a number", "location": "queries"}
+				]
+			}`,
+		},
+		{
+			name:        "Uses the configured status and problem type.",
+			url:         "/test?page=1",
+			body:        `{}`,
+			opts:        &RespondOpts{Status: http.StatusBadRequest, Renderer: NewProblemDetailsRenderer("https://example.com/probs/validation", "Invalid request")},
+			status:      http.StatusBadRequest,
+			contentType: ProblemJSONContentType,
+			response: `{
+				"type": "https://example.com/probs/validation",
+				"title": "Invalid request",
+				"status": 400,
+				"detail": "The request has 1 invalid parameter.",
+				"invalid-params": [{"name": "email", "reason": "email is required", "location": "body", "code": "required"}]
+			}`,
+		},
+		{
+			name:        "Renders JSON:API error objects.",
+			url:         "/test?page=x",
+			body:        `{}`,
+			opts:        &RespondOpts{Renderer: RenderJSONAPI},
+			status:      http.StatusUnprocessableEntity,
+			contentType: JSONAPIContentType,
+			response: `{"errors": [
+				{"status": "422", "code": "required", "title": "Unprocessable Entity", "detail": "email is required", "source": {"pointer": "/email"}, "meta": {"location": "body", "field": "email"}},
+				{"status": "422", "title": "Unprocessable Entity", "detail": "page must be a number", "source": {"parameter": "page"}, "meta": {"location": "queries", "field": "page"}}
+			]}`,
+		},
+		{
+			name:        "Renders a map of messages by field.",
+			url:         "/test?page=x",
+			body:        `{}`,
+			opts:        &RespondOpts{Renderer: RenderFieldMap},
+			status:      http.StatusUnprocessableEntity,
+			contentType: "application/json; charset=utf-8",
+			response:    `{"errors": {"email": ["email is required"], "page": ["page must be a number"]}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			router.POST("/test",
+				NewBodyChain("email", nil).Exists().Bail().Email(nil).Validate(),
+				NewQueryChain("page", nil).Optional().Numeric(nil).WithMessage("page must be a number").Validate(),
+				RespondOnError(test.opts),
+				func(ctx *gin.Context) {
+					ctx.String(http.StatusOK, `"handled"`)
+				},
+			)
+
+			req, _ := http.NewRequest(http.MethodPost, test.url, bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != test.status {
+				t.Errorf("got status %d, want %d", w.Code, test.status)
+			}
+
+			if got := w.Header().Get("Content-Type"); got != test.contentType {
+				t.Errorf("got content type %q, want %q", got, test.contentType)
+			}
+
+			var got, want any
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatalf("invalid response %q: %v", w.Body.String(), err)
+			}
+			json.Unmarshal([]byte(test.response), &want)
+
+			if !cmp.Equal(got, want) {
+				t.Errorf("got response %s, want %s", w.Body.String(), test.response)
+			}
+		})
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "email", want: "/email"},
+		{field: "address.city", want: "/address/city"},
+		{field: "items[1].sku", want: "/items/1/sku"},
+		{field: "matrix[0][2]", want: "/matrix/0/2"},
+		{field: `files.report\.pdf`, want: "/files/report.pdf"},
+		{field: "a/b.c~d", want: "/a~1b/c~0d"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.field, func(t *testing.T) {
+			if got := jsonPointer(test.field); got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
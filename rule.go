@@ -1,6 +1,11 @@
 package ginvalidator
 
-import "github.com/gin-gonic/gin"
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+)
 
 // validationChainRule represents a rule used in the validation chain, controlling the flow of validation.
 type validationChainRule struct {
@@ -11,6 +16,11 @@ type validationChainRule struct {
 	shouldBail          bool                // Determines if validation should stop immediately on failure.
 	shouldSkip          bool                // Determines if this chain rule should be skipped.
 	validationErr       error               // The error returned by the validatorgo validator, if any.
+	typedValue          any                 // The Go value of newValue for sanitizers that convert it (e.g. an int for ToInt).
+	internalErr         error               // The infrastructure error of a custom validator, if any. The value is then neither valid nor invalid.
+	absentValues        AbsentValues        // The values that count as absent for the Optional modifier and the Exists validator.
+	checkFile           *fileCheckFunc      // The check of a file validator, run by the chain against the file being validated; a pointer, so rules stay comparable.
+	fieldErr            *fieldErrFunc       // The error of a failed validator naming its field, created by the chain for the concrete field; a pointer, so rules stay comparable.
 }
 
 // newValidationChainRule creates a new validationChainRule with the specified options.
@@ -72,6 +82,43 @@ func withValidationErr(err error) func(*validationChainRule) {
 	}
 }
 
+// withInternalErr sets the internalErr field with the infrastructure error returned by a custom validator.
+func withInternalErr(err error) func(*validationChainRule) {
+	return func(vcr *validationChainRule) {
+		vcr.internalErr = err
+	}
+}
+
+// withAbsentValues sets the absentValues field with the values that count as absent.
+func withAbsentValues(values AbsentValues) func(*validationChainRule) {
+	return func(vcr *validationChainRule) {
+		vcr.absentValues = values
+	}
+}
+
+// withTypedValue sets the typedValue field with the Go value the sanitized value was converted to.
+func withTypedValue(typedValue any) func(*validationChainRule) {
+	return func(vcr *validationChainRule) {
+		vcr.typedValue = typedValue
+	}
+}
+
+// withCheckFile sets the checkFile field with the check of a file validator.
+func withCheckFile(check fileCheckFunc) func(*validationChainRule) {
+	return func(vcr *validationChainRule) {
+		vcr.checkFile = &check
+	}
+}
+
+// withFieldErr sets the fieldErr field with the error of a failed validator naming its field, if any.
+func withFieldErr(fieldErr fieldErrFunc) func(*validationChainRule) {
+	return func(vcr *validationChainRule) {
+		if fieldErr != nil {
+			vcr.fieldErr = &fieldErr
+		}
+	}
+}
+
 // func newValidationChainRule(isValid bool, newValue string, validationChainName string, validationChainType string, shouldBail bool, shouldNegate bool) validationChainRule {
 // 	return validationChainRule{
 // 		isValid:      isValid,
@@ -90,3 +137,107 @@ type ruleCreatorFunc func(ctx *gin.Context, initialValue, sanitizedValue string)
 // ruleCreatorFuncs is a slice of ruleCreatorFunc, allowing multiple rule functions
 // to be applied sequentially in a validation chain.
 type ruleCreatorFuncs []ruleCreatorFunc
+
+// ruleDescriptor describes a rule of a validation chain without having to run it.
+type ruleDescriptor struct {
+	chainType  validationChainType // The type of chain (e.g., validator, sanitizer).
+	name       string              // The name of the validator, e.g. EmailValidatorName.
+	params     map[string]any      // The arguments of the validator, available to message templates (see newRuleParams).
+	arrayLevel bool                // Whether the validator checks all the values of a multi-value field at once.
+	errFmtFunc ErrFmtFunc          // The function creating the error message of this validator only, set by WithMessage.
+	message    string              // The message set by WithMessage, describing errFmtFunc; empty for WithMessageFunc.
+}
+
+// newRuleParams collects the arguments of a validator into the params of its descriptor.
+// The exported fields of the options struct opts (which may be a nil pointer) are flattened into params,
+// skipping nil pointers, and keysAndValues holds the other arguments as name and value pairs.
+// Every key is in lower camel case, e.g. the MinOccurrences option is "minOccurrences".
+func newRuleParams(opts any, keysAndValues ...any) map[string]any {
+	params := make(map[string]any)
+
+	rv := reflect.ValueOf(opts)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() == reflect.Struct {
+		for i := 0; i < rv.NumField(); i++ {
+			sf := rv.Type().Field(i)
+			fv := rv.Field(i)
+
+			if !sf.IsExported() {
+				continue
+			}
+
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+
+			params[lowerCamelCase(sf.Name)] = fv.Interface()
+		}
+	}
+
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		if key, ok := keysAndValues[i].(string); ok {
+			params[key] = keysAndValues[i+1]
+		}
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	return params
+}
+
+// lowerCamelCase lower-cases the leading upper-case letters of a Go identifier, keeping the first letter of
+// the next word, e.g. "MinOccurrences" becomes "minOccurrences" and "URLPath" becomes "urlPath".
+func lowerCamelCase(name string) string {
+	runes := []rune(name)
+
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
+
+// ruleDescriptors is a slice of ruleDescriptor, holding the descriptor of every rule
+// in a validation chain at the same index as its ruleCreatorFunc.
+type ruleDescriptors []ruleDescriptor
+
+// ruleLevel determines which rules of a chain run against a value.
+type ruleLevel int
+
+const (
+	// allRuleLevels runs every rule against the single value of a field.
+	allRuleLevels ruleLevel = iota
+
+	// elementRuleLevel runs every rule except array-level validators against one value of a multi-value field.
+	elementRuleLevel
+
+	// arrayRuleLevel runs array-level validators and modifiers against all the values of a multi-value field,
+	// which are encoded as a JSON array of strings. Sanitizers are applied to each value.
+	arrayRuleLevel
+)
+
+// runs reports whether a rule with the given descriptor runs at the level.
+func (l ruleLevel) runs(descriptor ruleDescriptor) bool {
+	switch l {
+	case elementRuleLevel:
+		return !descriptor.arrayLevel
+	case arrayRuleLevel:
+		return descriptor.arrayLevel || descriptor.chainType != validatorType
+	default:
+		return true
+	}
+}
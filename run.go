@@ -0,0 +1,125 @@
+package ginvalidator
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrNilCtxRun is returned when a nil context is provided to Run, making it impossible to validate the request.
+var ErrNilCtxRun = errors.New("nil context provided: unable to run validation chain")
+
+// RunOpts configures how [ValidationChain.RunWith] and [RunAllWith] run chains.
+type RunOpts struct {
+	// DryRun leaves the validation result and matched data of the request untouched,
+	// so the outcome is only known to the caller.
+	DryRun bool
+}
+
+// ChainResult is the outcome of running a validation chain with [ValidationChain.Run].
+type ChainResult struct {
+	Errors      []ValidationChainError // the validation errors of the chain, in the order they occurred
+	Value       string                 // the sanitized value of the field; of the first field matched for a wildcard or NewCheck chain
+	MatchedData MatchedData            // the sanitized values of every field the chain matched
+}
+
+// IsEmpty reports whether the chain has no validation errors.
+func (r ChainResult) IsEmpty() bool {
+	return len(r.Errors) == 0
+}
+
+// Run validates the request with the chain from within a handler, without calling the next handler,
+// and returns the outcome. Like Validate, it saves the errors and matched data to the context;
+// use RunWith for a dry run.
+//
+// An infrastructure error of a [CustomValidatorCtxFunc] is returned as an [*InternalValidationError]
+// rather than reported to [InternalErrorHandler], and nothing of the chain is saved.
+//
+// Example:
+//
+//	func checkout(ctx *gin.Context) {
+//	  if ctx.Query("delivery") == "true" {
+//	    result, err := ginvalidator.NewBodyChain("shipping.address", nil).Not().Empty(nil).Run(ctx)
+//	    if err != nil {
+//	      ctx.AbortWithError(http.StatusInternalServerError, err)
+//	      return
+//	    }
+//	    if !result.IsEmpty() {
+//	      ctx.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"errors": result.Errors})
+//	      return
+//	    }
+//	  }
+//	  ...
+//	}
+func (v ValidationChain) Run(ctx *gin.Context) (ChainResult, error) {
+	return v.RunWith(ctx, nil)
+}
+
+// RunWith is like Run, with the options of opts.
+func (v ValidationChain) RunWith(ctx *gin.Context, opts *RunOpts) (ChainResult, error) {
+	if ctx == nil {
+		return ChainResult{}, ErrNilCtxRun
+	}
+
+	results := v.validate(ctx)
+
+	for _, result := range results {
+		if result.internalErr != nil {
+			return ChainResult{}, result.internalErr
+		}
+	}
+
+	chainRes := ChainResult{Errors: []ValidationChainError{}, MatchedData: make(MatchedData)}
+	valueSet := false
+
+	for _, result := range results {
+		if opts == nil || !opts.DryRun {
+			saveChainResultToCtx(ctx, result)
+		}
+
+		chainRes.Errors = append(chainRes.Errors, result.errors...)
+
+		if !result.extracted {
+			continue
+		}
+
+		if !valueSet {
+			chainRes.Value, valueSet = result.sanitizedValue, true
+		}
+
+		fields, ok := chainRes.MatchedData[result.location]
+		if !ok {
+			fields = make(MatchedDataFieldValues)
+			chainRes.MatchedData[result.location] = fields
+		}
+
+		fields[result.field] = result.sanitizedValue
+		for i, value := range result.sanitizedValues {
+			fields[indexedField(result.field, i)] = value
+		}
+	}
+
+	return chainRes, nil
+}
+
+// RunAll runs the chains in order with [ValidationChain.Run] and returns their outcomes in the same order.
+// It stops at the first infrastructure error, returning the outcomes of the chains before it.
+func RunAll(ctx *gin.Context, chains ...ValidationChain) ([]ChainResult, error) {
+	return RunAllWith(ctx, nil, chains...)
+}
+
+// RunAllWith is like [RunAll], with the options of opts.
+func RunAllWith(ctx *gin.Context, opts *RunOpts, chains ...ValidationChain) ([]ChainResult, error) {
+	results := make([]ChainResult, 0, len(chains))
+
+	for _, chain := range chains {
+		result, err := chain.RunWith(ctx, opts)
+		if err != nil {
+			return results, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
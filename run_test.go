@@ -0,0 +1,162 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestRun(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	errLookup := errors.New("lookup failed")
+	tooShort := &vgo.IsLengthOpts{Min: 10}
+
+	tests := []struct {
+		name  string
+		body  string
+		chain ValidationChain
+		opts  *RunOpts
+
+		result ChainResult
+		err    error
+		errs   []ValidationChainError
+		md     MatchedData
+	}{
+		{
+			name:  "Returns the sanitized value and saves it to the context.",
+			body:  `{"name": "  Ada  "}`,
+			chain: NewBodyChain("name", nil).Trim("").Length(&vgo.IsLengthOpts{Min: 2}),
+			result: ChainResult{
+				Errors:      []ValidationChainError{},
+				Value:       "Ada",
+				MatchedData: MatchedData{"body": MatchedDataFieldValues{"name": "Ada"}},
+			},
+			md: MatchedData{"body": MatchedDataFieldValues{"name": "Ada"}},
+		},
+		{
+			name:  "Returns the errors and saves them to the context.",
+			body:  `{"name": "Ada"}`,
+			chain: NewBodyChain("name", nil).Length(tooShort),
+			result: ChainResult{
+				Errors:      []ValidationChainError{{Location: "body", Field: "name", Value: "Ada", Message: DefaultErrMsg}},
+				Value:       "Ada",
+				MatchedData: MatchedData{"body": MatchedDataFieldValues{"name": "Ada"}},
+			},
+			errs: []ValidationChainError{{Location: "body", Field: "name", Value: "Ada", Message: DefaultErrMsg}},
+			md:   MatchedData{"body": MatchedDataFieldValues{"name": "Ada"}},
+		},
+		{
+			name:  "A dry run saves nothing.",
+			body:  `{"name": "Ada"}`,
+			chain: NewBodyChain("name", nil).Length(tooShort),
+			opts:  &RunOpts{DryRun: true},
+			result: ChainResult{
+				Errors:      []ValidationChainError{{Location: "body", Field: "name", Value: "Ada", Message: DefaultErrMsg}},
+				Value:       "Ada",
+				MatchedData: MatchedData{"body": MatchedDataFieldValues{"name": "Ada"}},
+			},
+		},
+		{
+			name:  "Returns every field of a wildcard chain.",
+			body:  `{"items": [{"sku": "A1"}, {"sku": "B2"}]}`,
+			chain: NewBodyChain("items.*.sku", nil),
+			result: ChainResult{
+				Errors:      []ValidationChainError{},
+				Value:       "A1",
+				MatchedData: MatchedData{"body": MatchedDataFieldValues{"items[0].sku": "A1", "items[1].sku": "B2"}},
+			},
+			md: MatchedData{"body": MatchedDataFieldValues{"items[0].sku": "A1", "items[1].sku": "B2"}},
+		},
+		{
+			name: "Returns infrastructure errors instead of reporting them.",
+			body: `{"email": "ada@example.com"}`,
+			chain: NewBodyChain("email", nil).CustomValidatorCtx(func(ctx *gin.Context, initialValue, sanitizedValue string) error {
+				return errLookup
+			}),
+			err: errLookup,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				result ChainResult
+				err    error
+				errs   []ValidationChainError
+				md     MatchedData
+			)
+
+			router := gin.New()
+			router.POST("/test", func(ctx *gin.Context) {
+				result, err = test.chain.RunWith(ctx, test.opts)
+				errs, _ = ValidationResult(ctx)
+				md, _ = GetMatchedData(ctx)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if !errors.Is(err, test.err) {
+				t.Errorf("got error %v, want %v", err, test.err)
+			}
+
+			if w.Code != http.StatusOK {
+				t.Errorf("got status %d, want %d", w.Code, http.StatusOK)
+			}
+
+			if !cmp.Equal(result, test.result, cmpopts.IgnoreUnexported(ValidationChainError{})) {
+				t.Errorf("got result %+v, want %+v", result, test.result)
+			}
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+
+			if !cmp.Equal(md, test.md, cmpopts.EquateEmpty()) {
+				t.Errorf("got matched data %+v, want %+v", md, test.md)
+			}
+		})
+	}
+}
+
+func TestRunAll(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var (
+		results []ChainResult
+		err     error
+	)
+
+	router := gin.New()
+	router.POST("/test", func(ctx *gin.Context) {
+		chains := []ValidationChain{NewBodyChain("name", nil).Length(&vgo.IsLengthOpts{Min: 10}), NewQueryChain("page", nil)}
+		results, err = RunAll(ctx, chains...)
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/test?page=2", bytes.NewBufferString(`{"name": "Ada"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(results) != 2 || len(results[0].Errors) != 1 || !results[1].IsEmpty() || results[1].Value != "2" {
+		t.Errorf("got results %+v, want an error for name and the page", results)
+	}
+
+	if _, err := NewBodyChain("name", nil).Run(nil); !errors.Is(err, ErrNilCtxRun) {
+		t.Errorf("got error %v, want %v", err, ErrNilCtxRun)
+	}
+}
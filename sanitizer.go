@@ -25,37 +25,51 @@ const (
 	WhitelistSanitizerName      string = "Whitelist"
 )
 
+// toDateLayout is the layout ToDate formats the sanitized value with.
+const toDateLayout string = "2006-01-02 15:04:05"
+
 // A sanitizer is simply a piece of the validation chain that can sanitize values from the specified field.
 type sanitizer struct {
 	field      string            // the field to be specified
 	errFmtFunc ErrFmtFunc // the function to create the error message
 
-	reqLoc            RequestLocation  // the HTTP request location (e.g., body, headers, cookies, params, or queries)
-	rulesCreatorFuncs ruleCreatorFuncs // the list of functions that creates the validation rules.
+	reqLoc            RequestLocation   // the HTTP request location (e.g., body, headers, cookies, params, or queries)
+	locations         []RequestLocation // the locations searched, in order, by a chain created with NewCheck; nil for any other chain
+	rulesCreatorFuncs ruleCreatorFuncs  // the list of functions that creates the validation rules.
+	ruleDescriptors   ruleDescriptors   // the descriptors of the validation rules, in the same order.
 }
 
 // recreateValidationChainFromSanitizer takes the previous sanitizer and returns a new validation chain.
-func (s *sanitizer) recreateValidationChainFromSanitizer(ruleCreatorFunc ruleCreatorFunc) ValidationChain {
-	newRulesCreatorFunc := append(s.rulesCreatorFuncs, ruleCreatorFunc)
+// The name and params of the sanitizer describe it, see [ValidationChain.Describe].
+func (s *sanitizer) recreateValidationChainFromSanitizer(ruleCreatorFunc ruleCreatorFunc, name string, params map[string]any) ValidationChain {
+	// Cap the slices so that chains sharing a common prefix never overwrite each other's rules.
+	newRulesCreatorFunc := append(s.rulesCreatorFuncs[:len(s.rulesCreatorFuncs):len(s.rulesCreatorFuncs)], ruleCreatorFunc)
+	newRuleDescriptors := append(s.ruleDescriptors[:len(s.ruleDescriptors):len(s.ruleDescriptors)], ruleDescriptor{chainType: sanitizerType, name: name, params: params})
 
 	return ValidationChain{
 		validator: validator{
 			field:             s.field,
 			reqLoc:            s.reqLoc,
+			locations:         s.locations,
 			errFmtFunc:        s.errFmtFunc,
 			rulesCreatorFuncs: newRulesCreatorFunc,
+			ruleDescriptors:   newRuleDescriptors,
 		},
 		modifier: modifier{
 			field:             s.field,
 			reqLoc:            s.reqLoc,
+			locations:         s.locations,
 			errFmtFunc:        s.errFmtFunc,
 			rulesCreatorFuncs: newRulesCreatorFunc,
+			ruleDescriptors:   newRuleDescriptors,
 		},
 		sanitizer: sanitizer{
 			field:             s.field,
 			reqLoc:            s.reqLoc,
+			locations:         s.locations,
 			errFmtFunc:        s.errFmtFunc,
 			rulesCreatorFuncs: newRulesCreatorFunc,
+			ruleDescriptors:   newRuleDescriptors,
 		},
 	}
 }
@@ -84,7 +98,7 @@ func (s sanitizer) CustomSanitizer(csf CustomSanitizerFunc) ValidationChain {
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, CustomSanitizerName, newRuleParams(nil))
 }
 
 // Blacklist is a sanitizer that remove characters that appear in the blacklist.
@@ -107,7 +121,7 @@ func (s sanitizer) Blacklist(blacklistedChars string) ValidationChain {
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, BlacklistSanitizerName, newRuleParams(nil, "blacklistedChars", blacklistedChars))
 }
 
 // Escape is a sanitizer that replaces <, >, &, ' and ". with HTML entities.
@@ -130,7 +144,7 @@ func (s sanitizer) Escape() ValidationChain {
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, EscapeSanitizerName, newRuleParams(nil))
 }
 
 // LTrim is a sanitizer that trims characters (whitespace by default) from the left-side of the input.
@@ -153,7 +167,7 @@ func (s sanitizer) LTrim(chars string) ValidationChain {
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, LTrimSanitizerName, newRuleParams(nil, "chars", chars))
 }
 
 // NormalizeEmail is a sanitizer that canonicalizes an email address. (This doesn't validate that the input is an email, if you want to validate the email use IsEmail beforehand).
@@ -176,7 +190,7 @@ func (s sanitizer) NormalizeEmail(opts *san.NormalizeEmailOpts) ValidationChain
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, NormalizeEmailSanitizerName, newRuleParams(opts))
 }
 
 // RTrim is a sanitizer that trims characters (whitespace by default) from the right-side of the input.
@@ -199,7 +213,7 @@ func (s sanitizer) RTrim(chars string) ValidationChain {
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, RTrimSanitizerName, newRuleParams(nil, "chars", chars))
 }
 
 // StripLow is a sanitizer that removes characters with a numerical value < 32 and 127, mostly control characters.
@@ -222,7 +236,7 @@ func (s sanitizer) StripLow(keepNewLines bool) ValidationChain {
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, StripLowSanitizerName, newRuleParams(nil, "keepNewLines", keepNewLines))
 }
 
 // ToBoolean is a A sanitizer that converts the input string to a boolean as s string "true" or "false"
@@ -245,12 +259,13 @@ func (s sanitizer) ToBoolean(strict bool) ValidationChain {
 		return newValidationChainRule(
 			withIsValid(true),
 			withNewValue(newValue),
+			withTypedValue(ok),
 			withValidationChainName(ToBooleanSanitizerName),
 			withValidationChainType(sanitizerType),
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, ToBooleanSanitizerName, newRuleParams(nil, "strict", strict))
 }
 
 // ToDate is a sanitizer that converts the value too a textual representation.
@@ -266,19 +281,22 @@ func (s sanitizer) ToDate() ValidationChain {
 		time := san.ToDate(sanitizedValue)
 		newValue := ""
 
+		var typedValue any
 		if time != nil {
-			newValue = time.Format("2006-01-02 15:04:05")
+			newValue = time.Format(toDateLayout)
+			typedValue = *time
 		}
 
 		return newValidationChainRule(
 			withIsValid(true),
 			withNewValue(newValue),
+			withTypedValue(typedValue),
 			withValidationChainName(ToDateSanitizerName),
 			withValidationChainType(sanitizerType),
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, ToDateSanitizerName, newRuleParams(nil))
 }
 
 // ToFloat is a sanitizer that converts the input string to a float64.
@@ -297,12 +315,13 @@ func (s sanitizer) ToFloat() ValidationChain {
 		return newValidationChainRule(
 			withIsValid(true),
 			withNewValue(newValue),
+			withTypedValue(float),
 			withValidationChainName(ToFloatSanitizerName),
 			withValidationChainType(sanitizerType),
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, ToFloatSanitizerName, newRuleParams(nil))
 }
 
 // ToInt is a sanitizer that converts the input string to an int and also returns an error if the input is not a int. (Beware of octals)
@@ -322,12 +341,13 @@ func (s sanitizer) ToInt() ValidationChain {
 		return newValidationChainRule(
 			withIsValid(true),
 			withNewValue(newValue),
+			withTypedValue(num),
 			withValidationChainName(ToIntSanitizerName),
 			withValidationChainType(sanitizerType),
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, ToIntSanitizerName, newRuleParams(nil))
 }
 
 // Trim is a sanitizer that trim characters (whitespace by default) from both sides of the input.
@@ -350,7 +370,7 @@ func (s sanitizer) Trim(chars string) ValidationChain {
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, TrimSanitizerName, newRuleParams(nil, "chars", chars))
 }
 
 // Unescape is a A sanitizer that replaces HTML encoded entities with <, >, &, ', ", `, \ and /.
@@ -373,7 +393,7 @@ func (s sanitizer) Unescape() ValidationChain {
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, UnescapeSanitizerName, newRuleParams(nil))
 }
 
 // Whitelist is a sanitizer that removes characters that do not appear in the whitelist.
@@ -396,7 +416,7 @@ func (s sanitizer) Whitelist(whitelistedChars string) ValidationChain {
 		)
 	}
 
-	return s.recreateValidationChainFromSanitizer(ruleCreator)
+	return s.recreateValidationChainFromSanitizer(ruleCreator, WhitelistSanitizerName, newRuleParams(nil, "whitelistedChars", whitelistedChars))
 }
 
 // newSanitizer creates and returns a new sanitizer.
@@ -3,6 +3,7 @@ package ginvalidator
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	san "github.com/bube054/validatorgo/sanitizer"
 )
@@ -66,7 +67,7 @@ func TestCustomSanitizer(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -131,7 +132,7 @@ func TestBlacklist(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -181,7 +182,7 @@ func TestEscape(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -246,7 +247,7 @@ func TestLTrim(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -298,7 +299,7 @@ func TestNormalizeEmail(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -363,7 +364,7 @@ func TestRTrim(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -428,7 +429,7 @@ func TestStripLow(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -459,6 +460,7 @@ func TestToBoolean(t *testing.T) {
 			want: newValidationChainRule(
 				withIsValid(true),
 				withNewValue("true"),
+				withTypedValue(true),
 				withValidationChainName(ToBooleanSanitizerName),
 				withValidationChainType(sanitizerType),
 			),
@@ -472,6 +474,7 @@ func TestToBoolean(t *testing.T) {
 			want: newValidationChainRule(
 				withIsValid(true),
 				withNewValue("false"),
+				withTypedValue(false),
 				withValidationChainName(ToBooleanSanitizerName),
 				withValidationChainType(sanitizerType),
 			),
@@ -493,7 +496,7 @@ func TestToBoolean(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -522,6 +525,7 @@ func TestToDate(t *testing.T) {
 			want: newValidationChainRule(
 				withIsValid(true),
 				withNewValue("2006-01-02 15:04:05"),
+				withTypedValue(time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)),
 				withValidationChainName(ToDateSanitizerName),
 				withValidationChainType(sanitizerType),
 			),
@@ -543,7 +547,7 @@ func TestToDate(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -572,6 +576,7 @@ func TestToFloat(t *testing.T) {
 			want: newValidationChainRule(
 				withIsValid(true),
 				withNewValue("123.000000"),
+				withTypedValue(123.0),
 				withValidationChainName(ToFloatSanitizerName),
 				withValidationChainType(sanitizerType),
 			),
@@ -593,7 +598,7 @@ func TestToFloat(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -622,6 +627,7 @@ func TestToInt(t *testing.T) {
 			want: newValidationChainRule(
 				withIsValid(true),
 				withNewValue("123"),
+				withTypedValue(123),
 				withValidationChainName(ToIntSanitizerName),
 				withValidationChainType(sanitizerType),
 			),
@@ -643,7 +649,7 @@ func TestToInt(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -708,7 +714,7 @@ func TestTrim(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -758,7 +764,7 @@ func TestUnescape(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -823,7 +829,7 @@ func TestWhitelist(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
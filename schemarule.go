@@ -0,0 +1,378 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	vgo "github.com/bube054/validatorgo"
+	san "github.com/bube054/validatorgo/sanitizer"
+)
+
+// SchemaRule is a validator, sanitizer or modifier of a field in a schema document read by [LoadSchema].
+type SchemaRule struct {
+	// Name is the name the rule is registered under, e.g. EmailValidatorName, matched regardless of case.
+	Name string
+
+	// Args are the arguments of the rule besides its options, e.g. the seed of Contains or the values of In.
+	Args []any
+
+	// Options are the fields of the options struct of the rule, e.g. {"min": 3, "max": 64} for Length,
+	// matched to the fields regardless of case. Without options the rule uses its defaults.
+	Options map[string]any
+
+	// Message, when set, is the error message of the rule, like [ValidationChain.WithMessage].
+	Message string
+}
+
+// DecodeArgs decodes the arguments of the rule into the values pointed to by dst, in order,
+// as encoding/json would. It fails unless the rule has exactly one argument per destination.
+func (r SchemaRule) DecodeArgs(dst ...any) error {
+	if len(r.Args) != len(dst) {
+		return fmt.Errorf("expected %d arguments, got %d", len(dst), len(r.Args))
+	}
+
+	for i, arg := range r.Args {
+		if err := decodeSchemaValue(arg, dst[i]); err != nil {
+			return fmt.Errorf("argument %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// DecodeOptions decodes the options of the rule into the struct pointed to by dst, as encoding/json would.
+// It fails on an option that is not a field of the struct.
+func (r SchemaRule) DecodeOptions(dst any) error {
+	if err := decodeSchemaValue(r.Options, dst); err != nil {
+		return fmt.Errorf("options: %w", err)
+	}
+
+	return nil
+}
+
+// decodeSchemaValue decodes a value of a schema document into the value pointed to by dst through its JSON form.
+func decodeSchemaValue(value, dst any) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+
+	return dec.Decode(dst)
+}
+
+// SchemaRuleBuilder adds a rule of a schema document to a chain, or returns an error when its arguments or options are invalid.
+type SchemaRuleBuilder func(vc ValidationChain, rule SchemaRule) (ValidationChain, error)
+
+var (
+	schemaRulesMu sync.RWMutex
+	schemaRules   = builtinSchemaRules()
+)
+
+// RegisterSchemaRule registers the builder of the rule name for the schema documents read by [LoadSchema]
+// and the `gv` tags compiled by [FromStruct], replacing any builder previously registered under the name,
+// matched regardless of case.
+//
+// Every validator, sanitizer and modifier that does not take a function is registered by default under its name,
+// e.g. EmailValidatorName or TrimSanitizerName. Register custom validators to reference them by name.
+//
+// Example:
+//
+//	ginvalidator.RegisterSchemaRule("EmailIsFree", func(vc ginvalidator.ValidationChain, rule ginvalidator.SchemaRule) (ginvalidator.ValidationChain, error) {
+//	  return vc.CustomValidatorCtx(emailIsFree), nil
+//	})
+func RegisterSchemaRule(name string, builder SchemaRuleBuilder) {
+	schemaRulesMu.Lock()
+	defer schemaRulesMu.Unlock()
+
+	schemaRules[strings.ToLower(name)] = builder
+}
+
+// LookupSchemaRule returns the builder registered under the rule name, matched regardless of case.
+func LookupSchemaRule(name string) (SchemaRuleBuilder, bool) {
+	schemaRulesMu.RLock()
+	defer schemaRulesMu.RUnlock()
+
+	builder, ok := schemaRules[strings.ToLower(name)]
+	return builder, ok
+}
+
+// builtinSchemaRules returns the builders of the validators, sanitizers and modifiers registered by default.
+func builtinSchemaRules() map[string]SchemaRuleBuilder {
+	rules := map[string]SchemaRuleBuilder{
+		BailModifierName:      noArgSchemaRule(ValidationChain.Bail),
+		NotModifierName:       noArgSchemaRule(ValidationChain.Not),
+		OptionalModifierName:  noArgSchemaRule(ValidationChain.Optional),
+		SensitiveModifierName: noArgSchemaRule(ValidationChain.Sensitive),
+
+		AbaRoutingValidatorName:         noArgSchemaRule(ValidationChain.AbaRouting),
+		AfterValidatorName:              optsSchemaRule(ValidationChain.After),
+		AlphaValidatorName:              optsSchemaRule(ValidationChain.Alpha),
+		AlphanumericValidatorName:       optsSchemaRule(ValidationChain.Alphanumeric),
+		ArrayValidatorName:              optsSchemaRule(ValidationChain.Array),
+		AsciiValidatorName:              noArgSchemaRule(ValidationChain.Ascii),
+		BTCAddressValidatorName:         noArgSchemaRule(ValidationChain.BTCAddress),
+		Base32ValidatorName:             optsSchemaRule(ValidationChain.Base32),
+		Base58ValidatorName:             noArgSchemaRule(ValidationChain.Base58),
+		Base64ValidatorName:             optsSchemaRule(ValidationChain.Base64),
+		BeforeValidatorName:             optsSchemaRule(ValidationChain.Before),
+		BicValidatorName:                noArgSchemaRule(ValidationChain.Bic),
+		BooleanValidatorName:            optsSchemaRule(ValidationChain.Boolean),
+		ByteLengthValidatorName:         optsSchemaRule(ValidationChain.ByteLength),
+		CreditCardValidatorName:         optsSchemaRule(ValidationChain.CreditCard),
+		CurrencyValidatorName:           optsSchemaRule(ValidationChain.Currency),
+		DataURIValidatorName:            noArgSchemaRule(ValidationChain.DataURI),
+		DateValidatorName:               optsSchemaRule(ValidationChain.Date),
+		DecimalValidatorName:            optsSchemaRule(ValidationChain.Decimal),
+		DivisibleByValidatorName:        argSchemaRule(ValidationChain.DivisibleBy),
+		EANValidatorName:                noArgSchemaRule(ValidationChain.EAN),
+		EmailValidatorName:              optsSchemaRule(ValidationChain.Email),
+		EmptyValidatorName:              optsSchemaRule(ValidationChain.Empty),
+		EqualsValidatorName:             argSchemaRule(ValidationChain.Equals),
+		EthereumAddressValidatorName:    noArgSchemaRule(ValidationChain.EthereumAddress),
+		ExistsValidatorName:             noArgSchemaRule(ValidationChain.Exists),
+		FQDNValidatorName:               optsSchemaRule(ValidationChain.FQDN),
+		FloatValidatorName:              optsSchemaRule(ValidationChain.Float),
+		FreightContainerIDValidatorName: noArgSchemaRule(ValidationChain.FreightContainerID),
+		FullWidthValidatorName:          noArgSchemaRule(ValidationChain.FullWidth),
+		HSLValidatorName:                noArgSchemaRule(ValidationChain.HSL),
+		HalfWidthValidatorName:          noArgSchemaRule(ValidationChain.HalfWidth),
+		HashValidatorName:               argSchemaRule(ValidationChain.Hash),
+		HexColorValidatorName:           noArgSchemaRule(ValidationChain.HexColor),
+		HexadecimalValidatorName:        noArgSchemaRule(ValidationChain.Hexadecimal),
+		IBANValidatorName:               argSchemaRule(ValidationChain.IBAN),
+		IMEIValidatorName:               optsSchemaRule(ValidationChain.IMEI),
+		IPValidatorName:                 argSchemaRule(ValidationChain.IP),
+		IPRangeValidatorName:            argSchemaRule(ValidationChain.IPRange),
+		ISINValidatorName:               noArgSchemaRule(ValidationChain.ISIN),
+		ISO31661Alpha2ValidatorName:     noArgSchemaRule(ValidationChain.ISO31661Alpha2),
+		ISO31661Alpha3ValidatorName:     noArgSchemaRule(ValidationChain.ISO31661Alpha3),
+		ISO31661NumericValidatorName:    noArgSchemaRule(ValidationChain.ISO31661Numeric),
+		ISO4217ValidatorName:            noArgSchemaRule(ValidationChain.ISO4217),
+		ISO6346ValidatorName:            noArgSchemaRule(ValidationChain.ISO6346),
+		ISO6391ValidatorName:            noArgSchemaRule(ValidationChain.ISO6391),
+		ISO8601ValidatorName:            optsSchemaRule(ValidationChain.ISO8601),
+		ISRCValidatorName:               argSchemaRule(ValidationChain.ISRC),
+		ISSNValidatorName:               optsSchemaRule(ValidationChain.ISSN),
+		IdentityCardValidatorName:       argSchemaRule(ValidationChain.IdentityCard),
+		InValidatorName:                 argSchemaRule(ValidationChain.In),
+		IntValidatorName:                optsSchemaRule(ValidationChain.Int),
+		ItemCountValidatorName:          optsSchemaRule(ValidationChain.ItemCount),
+		JSONValidatorName:               noArgSchemaRule(ValidationChain.JSON),
+		LatLongValidatorName:            optsSchemaRule(ValidationChain.LatLong),
+		LengthValidatorName:             optsSchemaRule(ValidationChain.Length),
+		LicensePlateValidatorName:       argSchemaRule(ValidationChain.LicensePlate),
+		LocaleValidatorName:             noArgSchemaRule(ValidationChain.Locale),
+		LowerCaseValidatorName:          noArgSchemaRule(ValidationChain.LowerCase),
+		LuhnNumberValidatorName:         noArgSchemaRule(ValidationChain.LuhnNumber),
+		MD5ValidatorName:                noArgSchemaRule(ValidationChain.MD5),
+		MacAddressValidatorName:         optsSchemaRule(ValidationChain.MacAddress),
+		MagnetURIValidatorName:          noArgSchemaRule(ValidationChain.MagnetURI),
+		MailtoURIValidatorName:          optsSchemaRule(ValidationChain.MailtoURI),
+		MimeTypeValidatorName:           noArgSchemaRule(ValidationChain.MimeType),
+		MongoIDValidatorName:            noArgSchemaRule(ValidationChain.MongoID),
+		MultibyteValidatorName:          noArgSchemaRule(ValidationChain.Multibyte),
+		NumericValidatorName:            optsSchemaRule(ValidationChain.Numeric),
+		ObjectValidatorName:             optsSchemaRule(ValidationChain.Object),
+		OctalValidatorName:              noArgSchemaRule(ValidationChain.Octal),
+		PassportNumberValidatorName:     argSchemaRule(ValidationChain.PassportNumber),
+		PortValidatorName:               noArgSchemaRule(ValidationChain.Port),
+		PostalCodeValidatorName:         argSchemaRule(ValidationChain.PostalCode),
+		RFC3339ValidatorName:            noArgSchemaRule(ValidationChain.RFC3339),
+		RgbColorValidatorName:           optsSchemaRule(ValidationChain.RgbColor),
+		SemVerValidatorName:             noArgSchemaRule(ValidationChain.SemVer),
+		SlugValidatorName:               noArgSchemaRule(ValidationChain.Slug),
+		StrongPasswordValidatorName:     optsSchemaRule(ValidationChain.StrongPassword),
+		SurrogatePairValidatorName:      noArgSchemaRule(ValidationChain.SurrogatePair),
+		TaxIDValidatorName:              argSchemaRule(ValidationChain.TaxID),
+		TimeValidatorName:               optsSchemaRule(ValidationChain.Time),
+		ULIDValidatorName:               noArgSchemaRule(ValidationChain.ULID),
+		URLValidatorName:                optsSchemaRule(ValidationChain.URL),
+		UUIDValidatorName:               argSchemaRule(ValidationChain.UUID),
+		UniqueItemsValidatorName:        noArgSchemaRule(ValidationChain.UniqueItems),
+		UpperCaseValidatorName:          noArgSchemaRule(ValidationChain.UpperCase),
+		VATValidatorName:                argSchemaRule(ValidationChain.VAT),
+		VariableWidthValidatorName:      noArgSchemaRule(ValidationChain.VariableWidth),
+		WhitelistedValidatorName:        argSchemaRule(ValidationChain.Whitelisted),
+
+		ContainsValidatorName: func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+			var seed string
+			if err := rule.DecodeArgs(&seed); err != nil {
+				return vc, err
+			}
+
+			opts, err := decodeSchemaOpts[vgo.ContainsOpt](rule)
+			return vc.Contains(seed, opts), err
+		},
+		MobilePhoneValidatorName: func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+			var locales []string
+			if err := rule.DecodeArgs(&locales); err != nil {
+				return vc, err
+			}
+
+			opts, err := decodeSchemaOpts[vgo.IsMobilePhoneOpts](rule)
+			return vc.MobilePhone(locales, opts), err
+		},
+		MatchesValidatorName: func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+			var pattern string
+			if err := rule.DecodeArgs(&pattern); err != nil {
+				return vc, err
+			}
+
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return vc, err
+			}
+			return vc.Matches(re), nil
+		},
+
+		EqualsFieldValidatorName:     fieldSchemaRule(ValidationChain.EqualsField),
+		AfterFieldValidatorName:      fieldSchemaRule(ValidationChain.AfterField),
+		BeforeFieldValidatorName:     fieldSchemaRule(ValidationChain.BeforeField),
+		RequiredWithoutValidatorName: fieldSchemaRule(ValidationChain.RequiredWithout),
+		RequiredIfValidatorName: func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+			var locName, field, value string
+			if err := rule.DecodeArgs(&locName, &field, &value); err != nil {
+				return vc, err
+			}
+
+			loc, ok := parseRequestLocation(locName)
+			if !ok {
+				return vc, fmt.Errorf("unknown request location %q", locName)
+			}
+			return vc.RequiredIf(loc, field, value), nil
+		},
+
+		FileMinSizeValidatorName:          argSchemaRule(ValidationChain.FileMinSize),
+		FileMaxSizeValidatorName:          argSchemaRule(ValidationChain.FileMaxSize),
+		FileMatchingMIMETypeValidatorName: noArgSchemaRule(ValidationChain.FileMatchingMIMEType),
+		ImageDimensionsValidatorName:      optsSchemaRule(ValidationChain.ImageDimensions),
+		SanitizeFilenameSanitizerName:     noArgSchemaRule(ValidationChain.SanitizeFilename),
+		FileExtensionsValidatorName: argSchemaRule(func(vc ValidationChain, extensions []string) ValidationChain {
+			return vc.FileExtensions(extensions...)
+		}),
+		FileMIMETypesValidatorName: argSchemaRule(func(vc ValidationChain, types []string) ValidationChain {
+			return vc.FileMIMETypes(types...)
+		}),
+
+		BlacklistSanitizerName:      argSchemaRule(ValidationChain.Blacklist),
+		EscapeSanitizerName:         noArgSchemaRule(ValidationChain.Escape),
+		LTrimSanitizerName:          optionalArgSchemaRule(ValidationChain.LTrim),
+		NormalizeEmailSanitizerName: optsSchemaRule[san.NormalizeEmailOpts](ValidationChain.NormalizeEmail),
+		RTrimSanitizerName:          optionalArgSchemaRule(ValidationChain.RTrim),
+		StripLowSanitizerName:       optionalArgSchemaRule(ValidationChain.StripLow),
+		ToBooleanSanitizerName:      optionalArgSchemaRule(ValidationChain.ToBoolean),
+		ToDateSanitizerName:         noArgSchemaRule(ValidationChain.ToDate),
+		ToFloatSanitizerName:        noArgSchemaRule(ValidationChain.ToFloat),
+		ToIntSanitizerName:          noArgSchemaRule(ValidationChain.ToInt),
+		TrimSanitizerName:           optionalArgSchemaRule(ValidationChain.Trim),
+		UnescapeSanitizerName:       noArgSchemaRule(ValidationChain.Unescape),
+		WhitelistSanitizerName:      argSchemaRule(ValidationChain.Whitelist),
+	}
+
+	lower := make(map[string]SchemaRuleBuilder, len(rules))
+	for name, builder := range rules {
+		lower[strings.ToLower(name)] = builder
+	}
+
+	return lower
+}
+
+// noArgSchemaRule returns the builder of a rule taking neither arguments nor options.
+func noArgSchemaRule(add func(ValidationChain) ValidationChain) SchemaRuleBuilder {
+	return func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+		if err := rule.DecodeArgs(); err != nil {
+			return vc, err
+		}
+
+		if len(rule.Options) > 0 {
+			return vc, fmt.Errorf("takes no options")
+		}
+
+		return add(vc), nil
+	}
+}
+
+// optsSchemaRule returns the builder of a rule taking an options struct, nil when the rule has no options.
+func optsSchemaRule[O any](add func(ValidationChain, *O) ValidationChain) SchemaRuleBuilder {
+	return func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+		if err := rule.DecodeArgs(); err != nil {
+			return vc, err
+		}
+
+		opts, err := decodeSchemaOpts[O](rule)
+		if err != nil {
+			return vc, err
+		}
+
+		return add(vc, opts), nil
+	}
+}
+
+// argSchemaRule returns the builder of a rule taking a single argument.
+func argSchemaRule[A any](add func(ValidationChain, A) ValidationChain) SchemaRuleBuilder {
+	return func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+		var arg A
+		if err := rule.DecodeArgs(&arg); err != nil {
+			return vc, err
+		}
+
+		if len(rule.Options) > 0 {
+			return vc, fmt.Errorf("takes no options")
+		}
+
+		return add(vc, arg), nil
+	}
+}
+
+// optionalArgSchemaRule is like argSchemaRule, for a rule whose argument may be left out for its zero value,
+// e.g. Trim, which trims whitespace without one.
+func optionalArgSchemaRule[A any](add func(ValidationChain, A) ValidationChain) SchemaRuleBuilder {
+	withArg := argSchemaRule(add)
+
+	return func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+		if len(rule.Args) == 0 {
+			var zero A
+			rule.Args = []any{zero}
+		}
+
+		return withArg(vc, rule)
+	}
+}
+
+// fieldSchemaRule returns the builder of a rule comparing with another field, whose arguments are its location and name.
+func fieldSchemaRule(add func(ValidationChain, RequestLocation, string) ValidationChain) SchemaRuleBuilder {
+	return func(vc ValidationChain, rule SchemaRule) (ValidationChain, error) {
+		var locName, field string
+		if err := rule.DecodeArgs(&locName, &field); err != nil {
+			return vc, err
+		}
+
+		loc, ok := parseRequestLocation(locName)
+		if !ok {
+			return vc, fmt.Errorf("unknown request location %q", locName)
+		}
+
+		return add(vc, loc, field), nil
+	}
+}
+
+// decodeSchemaOpts decodes the options of a rule, returning nil when it has none so the rule uses its defaults.
+func decodeSchemaOpts[O any](rule SchemaRule) (*O, error) {
+	if len(rule.Options) == 0 {
+		return nil, nil
+	}
+
+	opts := new(O)
+	if err := rule.DecodeOptions(opts); err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,205 @@
+package ginvalidator
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrValidationTimeout is the error of the [InternalValidationError] reported when the chains of [ValidateWith]
+// do not finish within ValidateOpts.Timeout.
+var ErrValidationTimeout = errors.New("validation timed out")
+
+// ValidateOpts configures how [ValidateWith] runs its chains.
+type ValidateOpts struct {
+	// Concurrency is the number of chains run at the same time. With 0 or 1 the chains run one after the other.
+	Concurrency int
+
+	// Timeout bounds the time the chains may take altogether; zero means no limit.
+	Timeout time.Duration
+}
+
+// Validate is a middleware running several validation chains, one after the other, as if each chain's
+// Validate middleware was stacked on the route. Use [ValidateWith] to run them concurrently.
+//
+// Example:
+//
+//	router.POST("/users",
+//	  ginvalidator.Validate(
+//	    ginvalidator.NewBodyChain("email", nil).Email(nil),
+//	    ginvalidator.NewBodyChain("name", nil).Not().Empty(nil),
+//	  ),
+//	  handler,
+//	)
+func Validate(chains ...ValidationChain) gin.HandlerFunc {
+	return ValidateWith(nil, chains...)
+}
+
+// ValidateWith is like [Validate], with a worker pool of opts.Concurrency chains and a timeout of opts.Timeout,
+// e.g. for chains whose custom validators look values up in a database.
+//
+// The errors and matched data of the chains are saved in the order of the chains, whichever finishes first,
+// so the result is the same as when they run one after the other. Concurrent chains must be independent:
+// they do not see each other's matched data, and their validators get a copy of the [gin.Context]
+// that must not write the response.
+//
+// The request context given to the validators is canceled when the client disconnects or the timeout expires.
+// No chain is started after that, and the middleware returns right away rather than waiting for the running chains,
+// which stop before their next rule; validators that block, e.g. on a database, should honor the context so they stop too.
+// Nothing of the group is saved and an [InternalValidationError] naming the first unfinished chain is reported
+// to [InternalErrorHandler], wrapping [ErrValidationTimeout] or the error of the request context.
+//
+// Example:
+//
+//	router.POST("/users",
+//	  ginvalidator.ValidateWith(&ginvalidator.ValidateOpts{Concurrency: 4, Timeout: 2 * time.Second},
+//	    ginvalidator.NewBodyChain("email", nil).CustomValidatorCtx(emailIsFree),
+//	    ginvalidator.NewBodyChain("username", nil).CustomValidatorCtx(usernameIsFree),
+//	  ),
+//	  handler,
+//	)
+func ValidateWith(opts *ValidateOpts, chains ...ValidationChain) gin.HandlerFunc {
+	var options ValidateOpts
+	if opts != nil {
+		options = *opts
+	}
+
+	workers := min(max(options.Concurrency, 1), max(len(chains), 1))
+
+	return func(ctx *gin.Context) {
+		if workers == 1 && options.Timeout <= 0 {
+			if runChains(ctx, chains) {
+				ctx.Next()
+			}
+			return
+		}
+
+		results, internalErr := runChainsConcurrently(ctx, chains, workers, options.Timeout)
+		if internalErr != nil {
+			handleInternalErr(ctx, internalErr)
+			if !ctx.IsAborted() {
+				ctx.Next()
+			}
+			return
+		}
+
+		for _, chainResults := range results {
+			for _, result := range chainResults {
+				saveChainResultToCtx(ctx, result)
+
+				if ctx.IsAborted() {
+					return
+				}
+			}
+		}
+
+		ctx.Next()
+	}
+}
+
+// runChainsConcurrently validates the chains with a pool of workers and returns their results in the order of the chains.
+// It returns an error instead when the request context is done, or the timeout expires, before every chain finished.
+func runChainsConcurrently(ctx *gin.Context, chains []ValidationChain, workers int, timeout time.Duration) ([][]chainResult, *InternalValidationError) {
+	reqCtx, cancel := context.WithCancel(ctx.Request.Context())
+	if timeout > 0 {
+		reqCtx, cancel = context.WithTimeoutCause(ctx.Request.Context(), timeout, ErrValidationTimeout)
+	}
+	defer cancel()
+
+	// The body is read before the workers start, so they share it instead of racing to read it.
+	if slices.ContainsFunc(chains, ValidationChain.readsBody) {
+		getRequestBody(ctx)
+	}
+
+	// Each chain gets its own copy of the context, made before the workers start since a gin.Context
+	// is not safe for concurrent use, whose request carries the context canceled on timeout.
+	workerCtxs := make([]*gin.Context, len(chains))
+	for i := range chains {
+		workerCtxs[i] = ctx.Copy()
+		workerCtxs[i].Request = ctx.Request.WithContext(reqCtx)
+	}
+
+	var (
+		results  = make([][]chainResult, len(chains))
+		finished = make([]bool, len(chains))
+		indexes  = make(chan int)
+		// done is buffered for every chain, so that a worker still running when the context is done never blocks on it.
+		done = make(chan int, len(chains))
+	)
+
+	for range workers {
+		go func() {
+			for i := range indexes {
+				results[i] = chains[i].validate(workerCtxs[i])
+				done <- i
+			}
+		}()
+	}
+
+	// canceledErr returns the error of the first chain stopped, or never started, because the context is done,
+	// or whose validator gave up on it. Only the results of finished chains are read, as the others may still be written.
+	canceledErr := func() *InternalValidationError {
+		for i, chain := range chains {
+			if finished[i] && !slices.ContainsFunc(results[i], chainResult.failedInternally) {
+				continue
+			}
+
+			return &InternalValidationError{
+				Location: chain.validator.reqLoc.String(),
+				Field:    chain.validator.field,
+				Err:      context.Cause(reqCtx),
+			}
+		}
+
+		return nil
+	}
+
+	for next, pending := 0, len(chains); pending > 0; {
+		feed := indexes
+		if next == len(chains) {
+			feed = nil
+		}
+
+		select {
+		case feed <- next:
+			if next++; next == len(chains) {
+				close(indexes)
+			}
+		case i := <-done:
+			finished[i] = true
+			pending--
+		case <-reqCtx.Done():
+			// The workers are not waited for: a validator that ignores the context would keep the request past the timeout.
+			// They own their copy of the context, and stop at their next rule.
+			if next < len(chains) {
+				close(indexes)
+			}
+			return nil, canceledErr()
+		}
+	}
+
+	if reqCtx.Err() == nil {
+		return results, nil
+	}
+
+	if err := canceledErr(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// failedInternally reports whether the chain was stopped by an infrastructure error.
+func (r chainResult) failedInternally() bool {
+	return r.internalErr != nil
+}
+
+// readsBody reports whether the chain validates a field or a file of the request body.
+func (v ValidationChain) readsBody() bool {
+	locations := append([]RequestLocation{v.validator.reqLoc}, v.validator.locations...)
+
+	return slices.Contains(locations, BodyLocation) || slices.Contains(locations, FileLocation)
+}
@@ -0,0 +1,259 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestValidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tooShort := &vgo.IsLengthOpts{Min: 10}
+
+	// rendezvous makes the validators of two chains wait for each other, which only works when they run concurrently.
+	rendezvous := func() (CustomValidatorCtxFunc, CustomValidatorCtxFunc) {
+		a, b := make(chan struct{}), make(chan struct{})
+
+		meet := func(arrive, wait chan struct{}) CustomValidatorCtxFunc {
+			return func(ctx *gin.Context, initialValue, sanitizedValue string) error {
+				close(arrive)
+				select {
+				case <-wait:
+					return NewValidationFailure(initialValue+" is taken", "")
+				case <-ctx.Request.Context().Done():
+					return ctx.Request.Context().Err()
+				}
+			}
+		}
+
+		return meet(a, b), meet(b, a)
+	}
+
+	blocked := func(ctx *gin.Context, initialValue, sanitizedValue string) error {
+		<-ctx.Request.Context().Done()
+		return ctx.Request.Context().Err()
+	}
+
+	tests := []struct {
+		name    string
+		body    string
+		handler func() gin.HandlerFunc
+
+		status  int
+		errs    []ValidationChainError
+		md      MatchedData
+		timeout bool
+	}{
+		{
+			name: "Runs the chains one after the other.",
+			body: `{"name": "Ada", "email": "ada"}`,
+			handler: func() gin.HandlerFunc {
+				return Validate(NewBodyChain("name", nil).Length(tooShort), NewBodyChain("email", nil).Length(tooShort))
+			},
+			status: http.StatusOK,
+			errs: []ValidationChainError{
+				{Location: "body", Field: "name", Value: "Ada", Message: DefaultErrMsg},
+				{Location: "body", Field: "email", Value: "ada", Message: DefaultErrMsg},
+			},
+			md: MatchedData{"body": MatchedDataFieldValues{"name": "Ada", "email": "ada"}},
+		},
+		{
+			name: "Runs the chains concurrently, saving their results in the order of the chains.",
+			body: `{"email": "ada@example.com", "username": "ada"}`,
+			handler: func() gin.HandlerFunc {
+				emailIsFree, usernameIsFree := rendezvous()
+
+				return ValidateWith(&ValidateOpts{Concurrency: 2, Timeout: time.Second},
+					NewBodyChain("email", nil).CustomValidatorCtx(emailIsFree),
+					NewBodyChain("username", nil).CustomValidatorCtx(usernameIsFree),
+				)
+			},
+			status: http.StatusOK,
+			errs: []ValidationChainError{
+				{Location: "body", Field: "email", Value: "ada@example.com", Message: "ada@example.com is taken"},
+				{Location: "body", Field: "username", Value: "ada", Message: "ada is taken"},
+			},
+			md: MatchedData{"body": MatchedDataFieldValues{"email": "ada@example.com", "username": "ada"}},
+		},
+		{
+			name: "Reports a timeout for the first unfinished chain.",
+			body: `{"name": "Ada", "email": "ada@example.com"}`,
+			handler: func() gin.HandlerFunc {
+				return ValidateWith(&ValidateOpts{Concurrency: 2, Timeout: 10 * time.Millisecond},
+					NewBodyChain("name", nil).Length(tooShort),
+					NewBodyChain("email", nil).CustomValidatorCtx(blocked),
+				)
+			},
+			status:  http.StatusInternalServerError,
+			timeout: true,
+		},
+		{
+			name: "Applies the timeout to chains run one after the other.",
+			body: `{"email": "ada@example.com"}`,
+			handler: func() gin.HandlerFunc {
+				return ValidateWith(&ValidateOpts{Timeout: 10 * time.Millisecond}, NewBodyChain("email", nil).CustomValidatorCtx(blocked))
+			},
+			status:  http.StatusInternalServerError,
+			timeout: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				errs    []ValidationChainError
+				md      MatchedData
+				ctxErrs []*gin.Error
+			)
+
+			router := gin.New()
+			router.Use(func(ctx *gin.Context) {
+				ctx.Next()
+				ctxErrs = ctx.Errors
+			})
+			router.POST("/test", test.handler(), func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+				md, _ = GetMatchedData(ctx)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != test.status {
+				t.Errorf("got status %d, want %d", w.Code, test.status)
+			}
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+
+			if !cmp.Equal(md, test.md, cmpopts.EquateEmpty()) {
+				t.Errorf("got matched data %+v, want %+v", md, test.md)
+			}
+
+			if !test.timeout {
+				return
+			}
+
+			if len(ctxErrs) != 1 {
+				t.Fatalf("got %d context errors, want 1", len(ctxErrs))
+			}
+
+			var internalErr *InternalValidationError
+			if !errors.As(ctxErrs[0].Err, &internalErr) || !errors.Is(internalErr, ErrValidationTimeout) {
+				t.Fatalf("got context error %v, want an *InternalValidationError wrapping ErrValidationTimeout", ctxErrs[0].Err)
+			}
+
+			if internalErr.Location != "body" || internalErr.Field != "email" {
+				t.Errorf("got the timeout of %s %q, want body %q", internalErr.Location, internalErr.Field, "email")
+			}
+		})
+	}
+}
+
+func TestValidateWithTimeoutStopsChains(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var started, running, laterRules atomic.Int32
+
+	// slow ignores the error of the canceled context, and takes a while to give up.
+	slow := func(ctx *gin.Context, initialValue, sanitizedValue string) error {
+		started.Add(1)
+		running.Add(1)
+		defer running.Add(-1)
+
+		<-ctx.Request.Context().Done()
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	}
+
+	later := func(r *http.Request, initialValue, sanitizedValue string) bool {
+		laterRules.Add(1)
+		return true
+	}
+
+	router := gin.New()
+	router.POST("/test", ValidateWith(&ValidateOpts{Concurrency: 2, Timeout: 10 * time.Millisecond},
+		NewBodyChain("email", nil).CustomValidatorCtx(slow).CustomValidator(later),
+		NewBodyChain("username", nil).CustomValidatorCtx(slow).CustomValidator(later),
+		NewBodyChain("name", nil).CustomValidatorCtx(slow),
+	))
+
+	req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(`{"email": "ada@example.com", "username": "ada", "name": "Ada"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	// The middleware does not wait for the running validators, which stop in the background.
+	for deadline := time.Now().Add(time.Second); running.Load() != 0; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d validators still running a second after the timeout, want 0", running.Load())
+		}
+	}
+
+	if n := started.Load(); n != 2 {
+		t.Errorf("got %d chains started, want 2: the last one is queued until the timeout", n)
+	}
+
+	if n := laterRules.Load(); n != 0 {
+		t.Errorf("got %d rules run after the timeout, want 0", n)
+	}
+}
+
+func TestValidateWithTimeoutDoesNotWaitForValidators(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	// stuck ignores the context of the request, and only returns once the test is over.
+	stuck := func(r *http.Request, initialValue, sanitizedValue string) bool {
+		<-release
+		return true
+	}
+
+	var ctxErrs []*gin.Error
+	router := gin.New()
+	router.Use(func(ctx *gin.Context) {
+		ctx.Next()
+		ctxErrs = ctx.Errors
+	})
+	router.POST("/test", ValidateWith(&ValidateOpts{Timeout: 20 * time.Millisecond},
+		NewBodyChain("email", nil).CustomValidator(stuck),
+	))
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(`{"email": "ada@example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	served := make(chan struct{})
+	go func() {
+		router.ServeHTTP(w, req)
+		close(served)
+	}()
+
+	select {
+	case <-served:
+	case <-time.After(time.Second):
+		t.Fatal("the middleware is still waiting for a validator ignoring the context a second after the timeout")
+	}
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("got status %d, want %d", w.Code, http.StatusInternalServerError)
+	}
+
+	if len(ctxErrs) != 1 || !errors.Is(ctxErrs[0].Err, ErrValidationTimeout) {
+		t.Errorf("got context errors %v, want one wrapping ErrValidationTimeout", ctxErrs)
+	}
+}
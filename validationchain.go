@@ -12,16 +12,14 @@
 package ginvalidator
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
-	"log"
-	"sync/atomic"
 
 	vgo "github.com/bube054/validatorgo"
 	"github.com/gin-gonic/gin"
 )
 
-var globalErrorOrder uint64
-
 const DefaultErrMsg string = "Invalid value"
 
 // DefaultErrFmtFunc is a package-level fallback error message formatter.
@@ -37,167 +35,586 @@ type ValidationChain struct {
 }
 
 type chainResult struct {
-	errors         []ValidationChainError
-	location       string
-	field          string
-	sanitizedValue string
+	errors          []ValidationChainError
+	location        string
+	field           string
+	sanitizedValue  string
+	sanitizedValues []string // the sanitized values of a field sent more than once, saved as indexed matched data
+	typedValue      any      // the Go value of sanitizedValue, if the last sanitizer converted it (e.g. ToInt)
+	typedValues     []any    // the Go values of sanitizedValues
+	extracted       bool     // whether the field was sent and could be extracted from the request; only extracted fields are matched data
+
+	internalErr     *InternalValidationError // the infrastructure error that stopped the chain, if any
+	firstFailedRule int                      // the index of the first validator that failed, or -1
 }
 
-func (v ValidationChain) validate(ctx *gin.Context) chainResult {
+// fieldInstances resolves the chain's field into the concrete fields to validate.
+// A body field containing "*" or "**" segments may expand to any number of fields,
+// every other field resolves to exactly one. Query, header, form and file fields carry all the values they were sent with.
+func (v ValidationChain) fieldInstances(ctx *gin.Context) []fieldInstance {
+	field := v.validator.field
+
+	if v.validator.reqLoc == FileLocation {
+		return []fieldInstance{extractFileInstance(ctx, field)}
+	}
+
+	if v.validator.reqLoc == BodyLocation && hasWildcardSegment(field) {
+		instances, err := extractWildcardFieldValsFromBody(ctx, field)
+		if err == nil {
+			return instances
+		}
+
+		return []fieldInstance{{field: field, err: err}}
+	}
+
 	var (
-		initialValue   string
-		sanitizedValue string
-		extractionErr  error
+		value  string
+		values []string
+		kind   valueKind
+		err    error
 	)
 
-	field := v.validator.field
-	reqLoc := v.validator.reqLoc
-	location := reqLoc.String()
-	errFmtFunc := v.validator.errFmtFunc
-
 	switch v.validator.reqLoc {
 	case 0:
-		initialValue, extractionErr = extractFieldValFromBody(ctx, field)
+		var isForm bool
+		values, isForm, err = extractFieldValsFromBody(ctx, field)
+		if err == nil && isForm {
+			return []fieldInstance{{field: field, values: values, kind: valuesKind(values), multiValue: true}}
+		}
+		value, kind, err = extractFieldValFromBody(ctx, field)
 	case 1:
-		initialValue, extractionErr = extractFieldValFromCookie(ctx, field)
+		value, kind, err = extractFieldValFromCookie(ctx, field)
 	case 2:
-		initialValue, extractionErr = extractFieldValFromHeader(ctx, field)
+		values, err = extractFieldValsFromHeader(ctx, field)
+		return []fieldInstance{{field: field, values: values, kind: valuesKind(values), multiValue: true, err: err}}
 	case 3:
-		initialValue, extractionErr = extractFieldValFromParam(ctx, field)
+		value, kind, err = extractFieldValFromParam(ctx, field)
 	case 4:
-		initialValue, extractionErr = extractFieldValFromQuery(ctx, field)
+		values, err = extractFieldValsFromQuery(ctx, field)
+		return []fieldInstance{{field: field, values: values, kind: valuesKind(values), multiValue: true, err: err}}
 	}
 
-	sanitizedValue = initialValue
+	return []fieldInstance{{field: field, value: value, kind: kind, err: err}}
+}
 
-	if extractionErr != nil {
-		log.Printf("Error extracting field %q from request location %q: %v", field, location, extractionErr)
+// validate runs the chain once for every concrete field it resolves to.
+// It stops early when the request context is done, e.g. when the timeout of ValidateWith expired.
+func (v ValidationChain) validate(ctx *gin.Context) []chainResult {
+	if err := requestCtxErr(ctx); err != nil {
+		return []chainResult{v.canceledResult(v.validator.field, "", err)}
 	}
 
-	ruleCreators := v.validator.rulesCreatorFuncs
-	valErrs := make([]ValidationChainError, 0, len(ruleCreators))
+	if len(v.validator.locations) > 0 {
+		return v.validateLocations(ctx)
+	}
 
-	numOfPreviousValidatorsFailed := 0
-	shouldNegateNextValidator := false
-	shouldSkipNextValidator := false
+	return v.validateInstances(ctx, v.fieldInstances(ctx))
+}
+
+// validateLocations runs a chain created with NewCheck against the field in every location it was sent to,
+// in the order of the chain's locations. A field sent to none of them is validated as missing from the first one.
+func (v ValidationChain) validateLocations(ctx *gin.Context) []chainResult {
+	var results []chainResult
+
+	for _, loc := range v.validator.locations {
+		chain := v.atLocation(loc)
 
-	for _, ruleCreator := range ruleCreators {
-		if shouldSkipNextValidator {
-			shouldSkipNextValidator = false
+		var found []fieldInstance
+		for _, instance := range chain.fieldInstances(ctx) {
+			if instance.kind != missingValue && instance.err == nil {
+				found = append(found, instance)
+			}
+		}
+
+		results = append(results, chain.validateInstances(ctx, found)...)
+	}
+
+	if len(results) > 0 {
+		return results
+	}
+
+	first := v.atLocation(v.validator.locations[0])
+
+	return first.validateInstances(ctx, first.fieldInstances(ctx))
+}
+
+// atLocation returns a copy of the chain that validates the field at the given location only.
+func (v ValidationChain) atLocation(loc RequestLocation) ValidationChain {
+	v.validator.reqLoc, v.validator.locations = loc, nil
+	v.modifier.reqLoc, v.modifier.locations = loc, nil
+	v.sanitizer.reqLoc, v.sanitizer.locations = loc, nil
+
+	return v
+}
+
+// validateInstances runs the chain once for every concrete field.
+func (v ValidationChain) validateInstances(ctx *gin.Context, instances []fieldInstance) []chainResult {
+	results := make([]chainResult, 0, len(instances))
+
+	for _, instance := range instances {
+		if err := requestCtxErr(ctx); err != nil {
+			return append(results, v.canceledResult(instance.field, "", err))
+		}
+
+		if instance.multiValue && instance.err == nil {
+			results = append(results, v.validateMultiValueInstance(ctx, instance))
 			continue
 		}
 
-		rule := ruleCreator(ctx, initialValue, sanitizedValue)
-		vcn := rule.validationChainName
-		valid := rule.isValid
-		newValue := rule.newValue
-		shouldBail := rule.shouldBail
-		shouldSkip := rule.shouldSkip
-		validationErr := rule.validationErr
-
-		var errMsg string
-
-		switch {
-		case errFmtFunc != nil:
-			errMsg = errFmtFunc(initialValue, sanitizedValue, vcn)
-		case DefaultErrFmtFunc != nil:
-			errMsg = DefaultErrFmtFunc(initialValue, sanitizedValue, vcn)
-		case validationErr != nil:
-			var ve *vgo.ValidationError
-			if errors.As(validationErr, &ve) {
-				errMsg = ve.Message
-			} else {
-				errMsg = validationErr.Error()
-			}
-		default:
-			errMsg = DefaultErrMsg
+		results = append(results, v.validateInstance(ctx, instance, allRuleLevels, -1))
+	}
+
+	return results
+}
+
+// validateMultiValueInstance runs the chain against a field that can be sent several times.
+//
+// Array-level validators run once against all the values, every other rule runs against each value.
+// A field sent at most once is reported under its own name, like any single-value field.
+// A field sent more than once is reported under an indexed name for each value (e.g. "tag[1]"),
+// and under its own name for array-level validators.
+func (v ValidationChain) validateMultiValueInstance(ctx *gin.Context, instance fieldInstance) chainResult {
+	result := chainResult{
+		location:        v.validator.reqLoc.String(),
+		field:           instance.field,
+		extracted:       instance.kind != missingValue,
+		firstFailedRule: -1,
+	}
+
+	if len(instance.values) < 2 {
+		element := fieldInstance{field: instance.field, kind: instance.kind}
+		if len(instance.values) == 1 {
+			element.value = instance.values[0]
+			element.file = instance.fileAt(0)
 		}
 
-		if rule.validationChainType == 0 {
-			if shouldNegateNextValidator {
-				valid = !valid
-				shouldNegateNextValidator = false
+		elementResult := v.validateInstance(ctx, element, elementRuleLevel, -1)
+		if elementResult.internalErr != nil {
+			return elementResult
+		}
+
+		result.errors = elementResult.errors
+		result.sanitizedValue = elementResult.sanitizedValue
+		result.typedValue = elementResult.typedValue
+		result.firstFailedRule = elementResult.firstFailedRule
+	} else {
+		result.sanitizedValues = make([]string, 0, len(instance.values))
+		result.typedValues = make([]any, 0, len(instance.values))
+
+		for i, value := range instance.values {
+			element := fieldInstance{field: indexedField(instance.field, i), value: value, file: instance.fileAt(i)}
+
+			elementResult := v.validateInstance(ctx, element, elementRuleLevel, -1)
+			if elementResult.internalErr != nil {
+				return elementResult
 			}
 
-			if !valid {
-				numOfPreviousValidatorsFailed++
+			result.errors = append(result.errors, elementResult.errors...)
+			result.firstFailedRule = earlierFailedRule(result.firstFailedRule, elementResult.firstFailedRule)
+			result.sanitizedValues = append(result.sanitizedValues, elementResult.sanitizedValue)
+			result.typedValues = append(result.typedValues, elementResult.typedValue)
+		}
 
-				order := atomic.AddUint64(&globalErrorOrder, 1)
+		// The field's own name holds its first value, like it did before multiple values were supported.
+		result.sanitizedValue = result.sanitizedValues[0]
+		result.typedValue = result.typedValues[0]
+	}
 
-				var code string
-				if validationErr != nil {
-					var ve *vgo.ValidationError
-					if errors.As(validationErr, &ve) {
-						code = ve.Code
-					}
-				}
+	if v.hasArrayLevelRules() {
+		// Validators that failed for any value count for the Bail modifiers of the array-level run.
+		arrayResult := v.validateInstance(ctx, instance, arrayRuleLevel, result.firstFailedRule)
+		if arrayResult.internalErr != nil {
+			return arrayResult
+		}
+
+		result.errors = append(result.errors, arrayResult.errors...)
+	}
+
+	return result
+}
+
+// earlierFailedRule returns the earlier of two failed validator indexes, where -1 means none.
+func earlierFailedRule(a, b int) int {
+	if a < 0 || (b >= 0 && b < a) {
+		return b
+	}
+
+	return a
+}
+
+// isAbsent reports whether the value of the field counts as absent for the Optional modifier or the Exists validator.
+// At the array level, only a field sent with no value at all is absent.
+func (instance fieldInstance) isAbsent(level ruleLevel, values AbsentValues) bool {
+	if level == arrayRuleLevel {
+		return len(instance.values) == 0
+	}
+
+	return values.includes(instance.kind, instance.value)
+}
+
+// hasArrayLevelRules reports whether the chain contains an array-level validator.
+func (v ValidationChain) hasArrayLevelRules() bool {
+	for _, descriptor := range v.validator.ruleDescriptors {
+		if descriptor.arrayLevel {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ruleDescriptor returns the descriptor of the rule at index i.
+func (v ValidationChain) ruleDescriptor(i int) ruleDescriptor {
+	if i < len(v.validator.ruleDescriptors) {
+		return v.validator.ruleDescriptors[i]
+	}
+
+	return ruleDescriptor{}
+}
+
+// encodeJSONStrings encodes values as a JSON array of strings.
+func encodeJSONStrings(values []string) string {
+	encoded, _ := json.Marshal(values)
+	return string(encoded)
+}
+
+// validateInstance runs the chain's rules of the given level against a single concrete field.
+// At the array level, the rules run against all the values of the field, encoded as a JSON array of strings.
+//
+// The rules run in order until a modifier halts the chain: Bail when a validator before it failed,
+// If when its condition is true and Optional when the value is absent. No rule after a halting modifier runs.
+// priorFailedRule is the index of the first validator that failed in an earlier run over the same field, or -1;
+// it halts at Bail modifiers placed after it.
+func (v ValidationChain) validateInstance(ctx *gin.Context, instance fieldInstance, level ruleLevel, priorFailedRule int) chainResult {
+	var (
+		initialValue    string
+		sanitizedValue  string
+		sanitizedValues []string
+		typedValue      any
+	)
+
+	field := instance.field
+	location := v.validator.reqLoc.String()
+
+	initialValue = instance.value
+	if level == arrayRuleLevel {
+		sanitizedValues = append([]string{}, instance.values...)
+		initialValue = encodeJSONStrings(instance.values)
+	}
+	sanitizedValue = initialValue
+
+	if instance.err != nil {
+		code := extractionErrCode(instance.err)
+
+		errMsg, ok := translateErrMsg(ctx, messageData{location: location, field: field, value: v.reportedValue(field, initialValue), code: code})
+		if !ok {
+			errMsg = instance.err.Error()
+		}
+
+		vce := newValidationChainError(
+			vceWithLocation(location),
+			vceWithMessage(errMsg),
+			vceWithField(field),
+			vceWithValue(v.reportedValue(field, initialValue)),
+			vceWithCode(code),
+		)
+
+		return chainResult{
+			errors:          []ValidationChainError{vce},
+			location:        location,
+			field:           field,
+			firstFailedRule: -1,
+		}
+	}
+
+	ruleCreators := v.validator.rulesCreatorFuncs
+	valErrs := make([]ValidationChainError, 0, len(ruleCreators))
 
-				vce := newValidationChainError(
-					vceWithLocation(location),
-					vceWithMessage(errMsg),
-					vceWithField(field),
-					vceWithValue(initialValue),
-					vceWithCode(code),
-					vceWithOrder(order),
-				)
+	firstFailedRule := -1
+	shouldNegateNextValidator := false
+	shouldSkipNextRule := false
+
+rules:
+	for i, ruleCreator := range ruleCreators {
+		if shouldSkipNextRule {
+			shouldSkipNextRule = false
+			continue
+		}
+
+		descriptor := v.ruleDescriptor(i)
 
-				valErrs = append(valErrs, vce)
+		if !level.runs(descriptor) {
+			// A negation applies to the next validator, even when it does not run at this level.
+			if descriptor.chainType == validatorType {
+				shouldNegateNextValidator = false
 			}
+			continue
 		}
 
-		if rule.validationChainType == 1 {
-			sanitizedValue = newValue
+		if err := requestCtxErr(ctx); err != nil {
+			result := v.canceledResult(field, descriptor.name, err)
+			result.errors, result.firstFailedRule = valErrs, firstFailedRule
+			return result
 		}
 
-		if rule.validationChainType == 2 {
-			vcn := rule.validationChainName
+		if level == arrayRuleLevel && descriptor.chainType == sanitizerType {
+			for j := range sanitizedValues {
+				sanitizedValues[j] = ruleCreator(ctx, instance.values[j], sanitizedValues[j]).newValue
+			}
+			sanitizedValue = encodeJSONStrings(sanitizedValues)
+			continue
+		}
 
-			if vcn == "Bail" {
-				if numOfPreviousValidatorsFailed > 0 {
-					break
-				}
+		rule := ruleCreator(ctx, initialValue, sanitizedValue)
+		if rule.checkFile != nil {
+			// Like Exists, file validators need the chain, which knows the file the value was sent with.
+			rule = rule.withCheckedFile(instance.file)
+		}
+		if rule.fieldErr != nil {
+			// The messages of cross-field validators name the concrete field, e.g. "items[0].end" rather than "items.*.end".
+			rule.validationErr = (*rule.fieldErr)(field)
+		}
+		vcn := rule.validationChainName
+
+		if rule.internalErr != nil {
+			return chainResult{
+				errors:   valErrs,
+				location: location,
+				field:    field,
+				internalErr: &InternalValidationError{
+					Location:  location,
+					Field:     field,
+					Validator: vcn,
+					Err:       rule.internalErr,
+				},
+				firstFailedRule: firstFailedRule,
 			}
+		}
 
-			if vcn == "If" {
-				if shouldBail {
-					break
-				}
+		switch rule.validationChainType {
+		case validatorType:
+			valid := rule.isValid
+			if vcn == ExistsValidatorName {
+				valid = !instance.isAbsent(level, rule.absentValues)
+			}
+			if shouldNegateNextValidator {
+				valid = !valid
+				shouldNegateNextValidator = false
 			}
 
-			if vcn == "Not" {
-				shouldNegateNextValidator = true
+			if valid {
+				continue
 			}
 
-			if vcn == "Skip" {
-				shouldSkipNextValidator = shouldSkip
+			if firstFailedRule < 0 {
+				firstFailedRule = i
 			}
 
-			if vcn == "Optional" {
-				if initialValue == "" {
+			code, errMsg := v.ruleErrCodeAndMessage(ctx, descriptor, rule, location, field, initialValue, sanitizedValue)
+
+			vce := newValidationChainError(
+				vceWithLocation(location),
+				vceWithMessage(errMsg),
+				vceWithField(field),
+				vceWithValue(v.reportedValue(field, initialValue)),
+				vceWithCode(code),
+			)
+
+			valErrs = append(valErrs, vce)
+		case sanitizerType:
+			sanitizedValue = rule.newValue
+			typedValue = rule.typedValue
+		case modifierType:
+			switch vcn {
+			case BailModifierName:
+				if earlierFailedRule(firstFailedRule, priorFailedRule) >= 0 {
+					break rules
+				}
+			case IfModifierName:
+				if rule.shouldBail {
+					break rules
+				}
+			case NotModifierName:
+				shouldNegateNextValidator = true
+			case SkipModifierName:
+				shouldSkipNextRule = rule.shouldSkip
+			case OptionalModifierName:
+				if instance.isAbsent(level, rule.absentValues) {
 					valErrs = make([]ValidationChainError, 0)
-					break
+					firstFailedRule = -1
+					break rules
 				}
 			}
 		}
 	}
 
+	if instance.file != nil {
+		typedValue = instance.file.matchedHeader(sanitizedValue)
+	}
+
+	return chainResult{
+		errors:          valErrs,
+		location:        location,
+		field:           field,
+		sanitizedValue:  sanitizedValue,
+		typedValue:      typedValue,
+		extracted:       instance.kind != missingValue,
+		firstFailedRule: firstFailedRule,
+	}
+}
+
+// canceledResult returns the result of a chain stopped before the given validator, if any,
+// because the request context is done.
+func (v ValidationChain) canceledResult(field, validator string, err error) chainResult {
+	location := v.validator.reqLoc.String()
+
 	return chainResult{
-		errors:         valErrs,
-		location:       location,
-		field:          field,
-		sanitizedValue: sanitizedValue,
+		location: location,
+		field:    field,
+		internalErr: &InternalValidationError{
+			Location:  location,
+			Field:     field,
+			Validator: validator,
+			Err:       err,
+		},
+		firstFailedRule: -1,
+	}
+}
+
+// requestCtxErr returns the cause of the cancellation of the request context, e.g. ErrValidationTimeout,
+// or nil while the request is running.
+func requestCtxErr(ctx *gin.Context) error {
+	if ctx == nil || ctx.Request == nil || ctx.Request.Context().Err() == nil {
+		return nil
+	}
+
+	return context.Cause(ctx.Request.Context())
+}
+
+// ruleErrCodeAndMessage returns the code and message of the error reported for a failed validator.
+//
+// The message is, by order of precedence, the one set with WithMessage, the chain's ErrFmtFunc,
+// DefaultErrFmtFunc, the translation of the request locale, the validatorgo message and DefaultErrMsg.
+func (v ValidationChain) ruleErrCodeAndMessage(ctx *gin.Context, descriptor ruleDescriptor, rule validationChainRule, location, field, initialValue, sanitizedValue string) (string, string) {
+	vcn := rule.validationChainName
+	errFmtFunc := v.validator.errFmtFunc
+
+	var ve *vgo.ValidationError
+	hasValidationErr := rule.validationErr != nil && errors.As(rule.validationErr, &ve)
+
+	var code string
+	if hasValidationErr {
+		code = ve.Code
+	}
+
+	switch {
+	case descriptor.errFmtFunc != nil:
+		return code, descriptor.errFmtFunc(initialValue, sanitizedValue, vcn)
+	case errFmtFunc != nil:
+		return code, errFmtFunc(initialValue, sanitizedValue, vcn)
+	case DefaultErrFmtFunc != nil:
+		return code, DefaultErrFmtFunc(initialValue, sanitizedValue, vcn)
+	}
+
+	if translated, ok := translateErrMsg(ctx, messageData{
+		location:  location,
+		field:     field,
+		value:     v.reportedValue(field, initialValue),
+		validator: vcn,
+		code:      code,
+		params:    descriptor.params,
+	}); ok {
+		return code, translated
+	}
+
+	switch {
+	case hasValidationErr:
+		return code, ve.Message
+	case rule.validationErr != nil:
+		return code, rule.validationErr.Error()
+	default:
+		return code, DefaultErrMsg
+	}
+}
+
+// saveChainResultToCtx saves the errors and, when the field could be extracted, the matched data of a chain result.
+// A result stopped by an infrastructure error is reported to InternalErrorHandler instead.
+func saveChainResultToCtx(ctx *gin.Context, result chainResult) {
+	if result.internalErr != nil {
+		handleInternalErr(ctx, result.internalErr)
+		return
+	}
+
+	saveValidationErrorsToCtx(ctx, result.errors)
+
+	if result.extracted {
+		saveMatchedDataToCtx(ctx, result.location, result.field, result.sanitizedValue)
+		saveTypedMatchedDataToCtx(ctx, result.location, result.field, result.typedValue)
+
+		for i, value := range result.sanitizedValues {
+			saveMatchedDataToCtx(ctx, result.location, indexedField(result.field, i), value)
+			saveTypedMatchedDataToCtx(ctx, result.location, indexedField(result.field, i), result.typedValues[i])
+		}
 	}
 }
 
 func (v ValidationChain) Validate() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		result := v.validate(ctx)
-		saveValidationErrorsToCtx(ctx, result.errors)
-		saveMatchedDataToCtx(ctx, result.location, result.field, result.sanitizedValue)
+		for _, result := range v.validate(ctx) {
+			saveChainResultToCtx(ctx, result)
+
+			if ctx.IsAborted() {
+				return
+			}
+		}
 		ctx.Next()
 	}
 }
 
+// WithMessage sets the error message of the validator added last to the chain,
+// e.g. NewBodyChain("email", nil).Email(nil).WithMessage("must be a valid email").
+//
+// The message overrides the chain's ErrFmtFunc and [DefaultErrFmtFunc] for that validator only.
+// Modifiers added after the validator are passed over, so Email(nil).Bail().WithMessage(...) still sets the message of Email,
+// and a validator negated by Not reports the message when the negated check fails.
+// WithMessage has no effect on a chain without validators.
+func (v ValidationChain) WithMessage(message string) ValidationChain {
+	return v.withLastValidatorMessage(func(initialValue, sanitizedValue, validatorName string) string {
+		return message
+	}, message)
+}
+
+// WithMessageFunc is like [ValidationChain.WithMessage], but creates the error message of the validator added last
+// to the chain with the given function.
+func (v ValidationChain) WithMessageFunc(errFmtFunc ErrFmtFunc) ValidationChain {
+	return v.withLastValidatorMessage(errFmtFunc, "")
+}
+
+// withLastValidatorMessage sets the function creating the error message of the validator added last to the chain,
+// and the message it describes, which is empty for a function set by WithMessageFunc.
+func (v ValidationChain) withLastValidatorMessage(errFmtFunc ErrFmtFunc, message string) ValidationChain {
+	descriptors := append(ruleDescriptors{}, v.validator.ruleDescriptors...)
+
+	for i := len(descriptors) - 1; i >= 0; i-- {
+		if descriptors[i].chainType == validatorType {
+			descriptors[i].errFmtFunc = errFmtFunc
+			descriptors[i].message = message
+			return v.withRuleDescriptors(descriptors)
+		}
+	}
+
+	return v
+}
+
+// withRuleDescriptors returns a copy of the chain with its rule descriptors replaced.
+func (v ValidationChain) withRuleDescriptors(descriptors ruleDescriptors) ValidationChain {
+	v.validator.ruleDescriptors = descriptors
+	v.modifier.ruleDescriptors = descriptors
+	v.sanitizer.ruleDescriptors = descriptors
+
+	return v
+}
+
 func newValidationChain(field string, errFmtFunc ErrFmtFunc, reqLoc RequestLocation) ValidationChain {
 	return ValidationChain{
 		validator: newValidator(field, errFmtFunc, reqLoc),
@@ -0,0 +1,382 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestWithMessage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	chainFmt := func(initialValue, sanitizedValue, validatorName string) string {
+		return "chain: " + validatorName
+	}
+
+	base := NewBodyChain("email", chainFmt).Email(nil)
+
+	// email and phone fail in their OneOf groups, then on their own, with the same messages.
+	email := NewBodyChain("email", nil).Email(nil).WithMessage("must be a valid email")
+	phone := NewBodyChain("phone", nil).Numeric(nil).WithMessage("must be numeric")
+
+	tests := []struct {
+		name   string
+		body   string
+		chains []gin.HandlerFunc
+
+		errs []ValidationChainError
+	}{
+		{
+			name:   "Overrides the message of the preceding validator only.",
+			body:   `{"email": "x"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("email", chainFmt).Email(nil).WithMessage("must be a valid email").Length(&vgo.IsLengthOpts{Min: 5}).Validate()},
+			errs: []ValidationChainError{
+				{Location: "body", Field: "email", Value: "x", Message: "must be a valid email"},
+				{Location: "body", Field: "email", Value: "x", Message: "chain: Length"},
+			},
+		},
+		{
+			name: "Func form receives the values and validator name.",
+			body: `{"email": " x "}`,
+			chains: []gin.HandlerFunc{NewBodyChain("email", nil).Trim("").Email(nil).WithMessageFunc(func(initialValue, sanitizedValue, validatorName string) string {
+				return fmt.Sprintf("%s: %q is not %q", validatorName, initialValue, sanitizedValue)
+			}).Validate()},
+			errs: []ValidationChainError{{Location: "body", Field: "email", Value: " x ", Message: `Email: " x " is not "x"`}},
+		},
+		{
+			name:   "Takes precedence over DefaultErrFmtFunc.",
+			body:   `{"email": "x"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("email", nil).Email(nil).WithMessage("must be a valid email").Validate()},
+			errs:   []ValidationChainError{{Location: "body", Field: "email", Value: "x", Message: "must be a valid email"}},
+		},
+		{
+			name:   "Applies to a validator negated by Not.",
+			body:   `{"email": "john@example.com"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("email", nil).Not().Email(nil).WithMessage("must not be an email").Validate()},
+			errs:   []ValidationChainError{{Location: "body", Field: "email", Value: "john@example.com", Message: "must not be an email"}},
+		},
+		{
+			name:   "Skips modifiers added after the validator.",
+			body:   `{"email": "x"}`,
+			chains: []gin.HandlerFunc{NewBodyChain("email", nil).Email(nil).Bail().WithMessage("must be a valid email").Validate()},
+			errs:   []ValidationChainError{{Location: "body", Field: "email", Value: "x", Message: "must be a valid email"}},
+		},
+		{
+			name:   "Has no effect without a validator.",
+			body:   `{"email": ""}`,
+			chains: []gin.HandlerFunc{NewBodyChain("email", nil).Trim("").WithMessage("unused").Not().Empty(nil).Validate()},
+			errs:   []ValidationChainError{{Location: "body", Field: "email", Value: "", Message: "chain"}},
+		},
+		{
+			name: "Chains sharing a prefix keep their own messages.",
+			body: `{"email": "x"}`,
+			chains: []gin.HandlerFunc{
+				base.WithMessage("first").Validate(),
+				base.WithMessage("second").Validate(),
+				base.Validate(),
+			},
+			errs: []ValidationChainError{
+				{Location: "body", Field: "email", Value: "x", Message: "first"},
+				{Location: "body", Field: "email", Value: "x", Message: "second"},
+				{Location: "body", Field: "email", Value: "x", Message: "chain: Email"},
+			},
+		},
+		{
+			name: "Applies inside OneOf groups when every group fails.",
+			body: `{"email": "x", "phone": "a"}`,
+			chains: []gin.HandlerFunc{
+				OneOf(
+					[]ValidationChain{email},
+					[]ValidationChain{phone},
+				),
+				email.Validate(),
+				phone.Validate(),
+			},
+			errs: []ValidationChainError{
+				{Field: "_oneOf", Message: "No group in OneOf passed validation"},
+				{Location: "body", Field: "email", Value: "x", Message: "must be a valid email"},
+				{Location: "body", Field: "phone", Value: "a", Message: "must be numeric"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			DefaultErrFmtFunc = func(initialValue, sanitizedValue, validatorName string) string {
+				return "chain"
+			}
+			t.Cleanup(func() { DefaultErrFmtFunc = nil })
+
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var errs []ValidationChainError
+			handlers := append(test.chains, func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+			})
+			router.POST("/test", handlers...)
+
+			req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.IgnoreFields(ValidationChainError{}, "Code"), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+		})
+	}
+}
+
+func TestChainControlFlow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	always := func(result bool) func(r *http.Request, initialValue, sanitizedValue string) bool {
+		return func(r *http.Request, initialValue, sanitizedValue string) bool {
+			return result
+		}
+	}
+
+	tests := []struct {
+		name  string
+		url   string
+		body  string
+		chain func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain
+
+		errs   []string
+		probed int
+		value  string
+	}{
+		{
+			name: "Bail halts after a failed validator.",
+			body: `{"value": "abc"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Numeric(nil).Bail().CustomValidator(probe)
+			},
+			errs:  []string{"value: Numeric"},
+			value: "abc",
+		},
+		{
+			name: "Bail continues after passing validators.",
+			body: `{"value": "123"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Numeric(nil).Bail().CustomValidator(probe)
+			},
+			errs:   []string{},
+			probed: 1,
+			value:  "123",
+		},
+		{
+			name: "Bail keeps the errors of every validator before it.",
+			body: `{"value": "!!"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Alpha(nil).Numeric(nil).Bail().CustomValidator(probe)
+			},
+			errs:  []string{"value: Alpha", "value: Numeric"},
+			value: "!!",
+		},
+		{
+			name: "First of several Bails halts.",
+			body: `{"value": "abc1"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Alpha(nil).Bail().Numeric(nil).Bail().CustomValidator(probe)
+			},
+			errs:  []string{"value: Alpha"},
+			value: "abc1",
+		},
+		{
+			name: "Bail halts after a negated validator fails.",
+			body: `{"value": "123"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Not().Numeric(nil).Bail().CustomValidator(probe)
+			},
+			errs:  []string{"value: Numeric"},
+			value: "123",
+		},
+		{
+			name: "Not before Bail negates the validator after Bail.",
+			body: `{"value": "123"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Not().Bail().Numeric(nil).CustomValidator(probe)
+			},
+			errs:   []string{"value: Numeric"},
+			probed: 1,
+			value:  "123",
+		},
+		{
+			name: "If halts when its condition is true.",
+			body: `{"value": "123"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Alpha(nil).If(IfModifierFunc(always(true))).CustomValidator(probe).Numeric(nil)
+			},
+			errs:  []string{"value: Alpha"},
+			value: "123",
+		},
+		{
+			name: "If continues when its condition is false.",
+			body: `{"value": "123"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Alpha(nil).If(IfModifierFunc(always(false))).CustomValidator(probe).Numeric(nil)
+			},
+			errs:   []string{"value: Alpha"},
+			probed: 1,
+			value:  "123",
+		},
+		{
+			name: "Optional halts and discards errors for a missing value.",
+			body: `{}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Numeric(nil).Optional().CustomValidator(probe).Alpha(nil)
+			},
+			errs: []string{},
+		},
+		{
+			name: "Optional continues for a present value.",
+			body: `{"value": "abc"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Optional().Numeric(nil).CustomValidator(probe)
+			},
+			errs:   []string{"value: Numeric"},
+			probed: 1,
+			value:  "abc",
+		},
+		{
+			name: "Bail after Optional halts for a present value.",
+			body: `{"value": "abc"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Optional().Numeric(nil).Bail().CustomValidator(probe)
+			},
+			errs:  []string{"value: Numeric"},
+			value: "abc",
+		},
+		{
+			name: "Skip skips the next validator.",
+			body: `{"value": "abc"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Skip(SkipModifierFunc(always(true))).Numeric(nil).CustomValidator(probe)
+			},
+			errs:   []string{},
+			probed: 1,
+			value:  "abc",
+		},
+		{
+			name: "Skip before a sanitizer skips the sanitizer.",
+			body: `{"value": " x "}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Skip(SkipModifierFunc(always(true))).Trim("").Equals("x")
+			},
+			errs:  []string{"value: Equals"},
+			value: " x ",
+		},
+		{
+			name: "Skip with a false condition runs the sanitizer.",
+			body: `{"value": " x "}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Skip(SkipModifierFunc(always(false))).Trim("").Equals("x")
+			},
+			errs:  []string{},
+			value: "x",
+		},
+		{
+			name: "Skip before Bail keeps the chain running.",
+			body: `{"value": "abc"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Numeric(nil).Skip(SkipModifierFunc(always(true))).Bail().CustomValidator(probe)
+			},
+			errs:   []string{"value: Numeric"},
+			probed: 1,
+			value:  "abc",
+		},
+		{
+			name: "Skip before Not cancels the negation.",
+			body: `{"value": "123"}`,
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Skip(SkipModifierFunc(always(true))).Not().Numeric(nil)
+			},
+			errs:  []string{},
+			value: "123",
+		},
+		{
+			name: "Bail halts after any failed value before array-level validators.",
+			url:  "/test?value=a&value=1&value=2",
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Numeric(nil).Bail().ItemCount(&ItemCountOpts{Max: vgo.Int(2)})
+			},
+			errs:  []string{"value[0]: Numeric"},
+			value: "a",
+		},
+		{
+			name: "Array-level validators run when every value passes.",
+			url:  "/test?value=0&value=1&value=2",
+			chain: func(vc ValidationChain, probe CustomValidatorFunc) ValidationChain {
+				return vc.Numeric(nil).Bail().ItemCount(&ItemCountOpts{Max: vgo.Int(2)})
+			},
+			errs:  []string{"value: ItemCount"},
+			value: "0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			probed := 0
+			probe := func(r *http.Request, initialValue, sanitizedValue string) bool {
+				probed++
+				return true
+			}
+
+			errFmtFunc := func(initialValue, sanitizedValue, validatorName string) string {
+				return validatorName
+			}
+
+			vc := NewBodyChain("value", errFmtFunc)
+			loc := BodyLocation
+			if test.url != "" {
+				vc = NewQueryChain("value", errFmtFunc)
+				loc = QueryLocation
+			}
+
+			var (
+				errs []ValidationChainError
+				md   MatchedData
+			)
+
+			router := gin.New()
+			router.POST("/test", test.chain(vc, probe).Validate(), func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+				md, _ = GetMatchedData(ctx)
+			})
+
+			url := test.url
+			if url == "" {
+				url = "/test"
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			got := make([]string, len(errs))
+			for i, err := range errs {
+				got[i] = err.Field + ": " + err.Message
+			}
+
+			if !cmp.Equal(got, test.errs) {
+				t.Errorf("got errors %q, want %q", got, test.errs)
+			}
+
+			if probed != test.probed {
+				t.Errorf("probe ran %d times, want %d", probed, test.probed)
+			}
+
+			if value, _ := md.Get(loc, "value"); value != test.value {
+				t.Errorf("got matched value %q, want %q", value, test.value)
+			}
+		})
+	}
+}
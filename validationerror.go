@@ -1,5 +1,12 @@
 package ginvalidator
 
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
 // ValidationChainError represents an error that occurred during the validation chain for a request.
 // It includes information about the location of the error, the message, the specific field involved,
 // the invalid value.
@@ -10,7 +17,7 @@ package ginvalidator
 //   - Field: The name of the field that failed validation.
 //   - Value: The invalid value that triggered the validation error.
 //   - Code: A machine-readable error code (e.g., "invalid_format") populated by validatorgo.
-//   - order: A monotonic counter used internally to preserve insertion order across chains.
+//   - order: The position of the error in the RequestResult of its request, used internally to preserve insertion order across chains.
 type ValidationChainError struct {
 	Location string `json:"location"`
 	Message  string `json:"message"`
@@ -78,3 +85,44 @@ func newValidationChainError(opts ...func(*ValidationChainError)) ValidationChai
 // Returns:
 //   - A string representing the formatted error message based on the provided values and validator.
 type ErrFmtFunc func(initialValue, sanitizedValue, validatorName string) string
+
+// InternalValidationError is an infrastructure error returned by a [CustomValidatorCtxFunc], such as a failed
+// database lookup. Unlike a [ValidationChainError], it says nothing about the validity of the value.
+type InternalValidationError struct {
+	Location  string // the location of the field, e.g. "body"
+	Field     string // the field being validated
+	Validator string // the name of the validator that failed, empty when the chain as a whole failed, e.g. timed out
+	Err       error  // the error returned by the validator
+}
+
+// Error returns the error message, naming the field and validator.
+func (e *InternalValidationError) Error() string {
+	if e.Validator == "" {
+		return fmt.Sprintf("%s %q: %v", e.Location, e.Field, e.Err)
+	}
+
+	return fmt.Sprintf("%s %q: %s: %v", e.Location, e.Field, e.Validator, e.Err)
+}
+
+// Unwrap returns the error returned by the validator, so e.g. errors.Is(err, context.Canceled) works.
+func (e *InternalValidationError) Unwrap() error {
+	return e.Err
+}
+
+// InternalErrorHandler is called with the infrastructure errors of custom validators. The default handler
+// aborts the request with 500 Internal Server Error and adds the error to ctx.Errors, where an error-handling
+// middleware can render it. Replace it to handle these errors differently, e.g. with 503 Service Unavailable.
+// A nil handler only adds the error to ctx.Errors and lets the request continue.
+var InternalErrorHandler func(ctx *gin.Context, err *InternalValidationError) = func(ctx *gin.Context, err *InternalValidationError) {
+	ctx.AbortWithError(http.StatusInternalServerError, err)
+}
+
+// handleInternalErr reports an infrastructure error to InternalErrorHandler.
+func handleInternalErr(ctx *gin.Context, err *InternalValidationError) {
+	if InternalErrorHandler == nil {
+		ctx.Error(err)
+		return
+	}
+
+	InternalErrorHandler(ctx, err)
+}
@@ -2,6 +2,7 @@ package ginvalidator
 
 import (
 	"errors"
+	"fmt"
 	"sort"
 
 	"github.com/gin-gonic/gin"
@@ -15,7 +16,7 @@ var (
 	ErrNoValidationResult = errors.New("validation result not found in context")
 )
 
-// GinValidatorCtxErrorsStoreName is the key, where the validation errors are stored.
+// GinValidatorCtxErrorsStoreName is the key, where the [RequestResult] of the request is stored.
 const GinValidatorCtxErrorsStoreName string = "__ginvalidator__ctx__errors__"
 
 // ctxFieldErrs represents a map where the key is the name of a field and the value is a slice of
@@ -33,122 +34,232 @@ type ctxFieldErrs map[string][]ValidationChainError
 // handle errors from different parts of the request context.
 type ctxStoreErrs map[string]ctxFieldErrs
 
-// ValidationResult extracts the validation errors from the Gin context.
-// It retrieves any validation errors that have occurred during the request processing,
-// and returns them as a slice of ValidationChainError structs along with any potential error.
-//
-// Parameters:
-//   - ctx: The Gin context, which provides access to the HTTP request and response, including validation error data.
-//
-// Returns:
-//   - A slice of ValidationChainError: Contains the details of each validation error encountered, including location, field, and message.
-//   - error: Returns an error if there is an issue extracting or processing the validation errors; otherwise, nil.
-func ValidationResult(ctx *gin.Context) ([]ValidationChainError, error) {
-	if ctx == nil {
-		return nil, ErrNilCtxValidationResult
-	}
+// ErrorFormatter converts a validation error into the value returned by [RequestResult.Array] and [RequestResult.Mapped],
+// e.g. a message or a struct of your API.
+type ErrorFormatter func(err ValidationChainError) any
 
-	data, ok := ctx.Get(GinValidatorCtxErrorsStoreName)
+// RequestResult holds the validation errors of a request, in the order they occurred.
+// It is created by the first validation chain of the request and retrieved with [Result].
+type RequestResult struct {
+	store     ctxStoreErrs
+	lastOrder uint64
+	formatter ErrorFormatter
+}
 
-	if !ok {
-		return nil, ErrNoValidationResult
+// Result returns the validation result of the request. A request no chain has validated yet,
+// or a nil context, has an empty result.
+//
+// Example:
+//
+//	func handler(ctx *gin.Context) {
+//	  result := ginvalidator.Result(ctx)
+//	  if !result.IsEmpty() {
+//	    ctx.JSON(http.StatusUnprocessableEntity, gin.H{"errors": result.Mapped()})
+//	    return
+//	  }
+//	  ...
+//	}
+func Result(ctx *gin.Context) *RequestResult {
+	result, err := resultFromCtx(ctx)
+	if err != nil {
+		return &RequestResult{}
 	}
 
-	var store ctxStoreErrs
-	store, ok = data.(ctxStoreErrs)
-
-	if !ok {
-		return nil, ErrNoValidationResult
-	}
+	return result
+}
 
+// Errors returns the validation errors in the order they occurred.
+func (r *RequestResult) Errors() []ValidationChainError {
 	var allErrs []ValidationChainError
 
-	for _, locations := range store {
+	for _, locations := range r.store {
 		for _, errs := range locations {
 			allErrs = append(allErrs, errs...)
 		}
 	}
 
-	// allErrs = RandomizeErrors(allErrs)
-
 	sortValidationErrors(allErrs)
 
-	return allErrs, nil
+	return allErrs
+}
+
+// IsEmpty reports whether the request has no validation errors.
+func (r *RequestResult) IsEmpty() bool {
+	return len(r.Errors()) == 0
 }
 
-// createErrNewStore initializes an empty ctxStoreErrs store and adds it to the context
-// under the key specified by GinValidatorCtxErrorsStoreName.
-func createErrNewStore(ctx *gin.Context) {
-	var newStore ctxStoreErrs
+// Array returns the formatted validation errors in the order they occurred.
+// With onlyFirstError, only the first error of each field is returned.
+func (r *RequestResult) Array(onlyFirstError bool) []any {
+	errs := r.Errors()
+	formatted := make([]any, 0, len(errs))
+	seen := make(map[string]bool)
 
-	ctx.Set(GinValidatorCtxErrorsStoreName, newStore)
+	for _, err := range errs {
+		if onlyFirstError {
+			if seen[err.Field] {
+				continue
+			}
+			seen[err.Field] = true
+		}
+
+		formatted = append(formatted, r.format(err))
+	}
+
+	return formatted
 }
 
-// saveValidationErrorsToCtx saves validation errors into the Gin context.
-func saveValidationErrorsToCtx(ctx *gin.Context, errs []ValidationChainError) {
-	if ctx == nil {
-		return
+// Mapped returns the first formatted validation error of each field, by field.
+func (r *RequestResult) Mapped() map[string]any {
+	mapped := make(map[string]any)
+
+	for _, err := range r.Errors() {
+		if _, exists := mapped[err.Field]; !exists {
+			mapped[err.Field] = r.format(err)
+		}
 	}
 
-	data, ok := ctx.Get(GinValidatorCtxErrorsStoreName)
+	return mapped
+}
 
-	if !ok {
-		createErrNewStore(ctx)
-		saveValidationErrorsToCtx(ctx, errs)
-		return
+// FormatWith returns a copy of the result whose Array and Mapped format the errors with formatter.
+// The result stored in the context is unchanged.
+//
+// Example:
+//
+//	messages := ginvalidator.Result(ctx).FormatWith(func(err ginvalidator.ValidationChainError) any {
+//	  return err.Message
+//	}).Mapped()
+func (r *RequestResult) FormatWith(formatter ErrorFormatter) *RequestResult {
+	formatted := *r
+	formatted.formatter = formatter
+
+	return &formatted
+}
+
+// Throw returns a [*ResultError] holding the validation errors, or nil when there are none.
+func (r *RequestResult) Throw() error {
+	errs := r.Errors()
+	if len(errs) == 0 {
+		return nil
 	}
 
-	var store ctxStoreErrs
-	store, ok = data.(ctxStoreErrs)
+	return &ResultError{Errors: errs}
+}
 
-	if !ok {
-		createErrNewStore(ctx)
-		saveValidationErrorsToCtx(ctx, errs)
-		return
+// format formats a validation error with the formatter of the result; without one the error is returned as it is.
+func (r *RequestResult) format(err ValidationChainError) any {
+	if r.formatter == nil {
+		return err
 	}
 
-	if store == nil {
-		store = make(ctxStoreErrs)
+	return r.formatter(err)
+}
+
+// add records validation errors, ordering them after the errors already recorded.
+func (r *RequestResult) add(errs []ValidationChainError) {
+	if r.store == nil {
+		r.store = make(ctxStoreErrs)
 	}
 
 	for _, err := range errs {
-		field := err.Field
-		location := err.Location
-
-		specificLocationStore, ok := store[location]
+		r.lastOrder++
+		err.order = r.lastOrder
 
+		fields, ok := r.store[err.Location]
 		if !ok {
-			specificLocationStore = make(ctxFieldErrs)
-			store[location] = specificLocationStore
+			fields = make(ctxFieldErrs)
+			r.store[err.Location] = fields
 		}
 
-		currentErrs, ok := specificLocationStore[field]
+		fields[err.Field] = append(fields[err.Field], err)
+	}
+}
 
-		if !ok {
-			currentErrs = make([]ValidationChainError, 0)
-			specificLocationStore[field] = currentErrs
-		}
+// ResultError is the error returned by [RequestResult.Throw] for a request with validation errors.
+type ResultError struct {
+	Errors []ValidationChainError // the validation errors, in the order they occurred
+}
+
+// Error returns the first validation error and how many others there are.
+func (e *ResultError) Error() string {
+	first := e.Errors[0]
+	msg := fmt.Sprintf("validation failed: %s %q: %s", first.Location, first.Field, first.Message)
+
+	switch others := len(e.Errors) - 1; others {
+	case 0:
+		return msg
+	case 1:
+		return msg + " (and 1 more error)"
+	default:
+		return fmt.Sprintf("%s (and %d more errors)", msg, others)
+	}
+}
+
+// ValidationResult extracts the validation errors from the Gin context.
+// It retrieves any validation errors that have occurred during the request processing,
+// and returns them as a slice of ValidationChainError structs along with any potential error.
+//
+// It is a shorthand for Result(ctx).Errors() that reports a missing result.
+//
+// Parameters:
+//   - ctx: The Gin context, which provides access to the HTTP request and response, including validation error data.
+//
+// Returns:
+//   - A slice of ValidationChainError: Contains the details of each validation error encountered, including location, field, and message.
+//   - error: Returns an error if there is an issue extracting or processing the validation errors; otherwise, nil.
+func ValidationResult(ctx *gin.Context) ([]ValidationChainError, error) {
+	result, err := resultFromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		currentErrs = append(currentErrs, err)
+	return result.Errors(), nil
+}
+
+// resultFromCtx returns the validation result stored in the context.
+func resultFromCtx(ctx *gin.Context) (*RequestResult, error) {
+	if ctx == nil {
+		return nil, ErrNilCtxValidationResult
+	}
+
+	data, ok := ctx.Get(GinValidatorCtxErrorsStoreName)
+	if !ok {
+		return nil, ErrNoValidationResult
+	}
+
+	result, ok := data.(*RequestResult)
+	if !ok {
+		return nil, ErrNoValidationResult
+	}
 
-		specificLocationStore[field] = currentErrs
+	return result, nil
+}
 
-		store[location] = specificLocationStore
+// saveValidationErrorsToCtx saves validation errors into the result of the request, creating it if needed.
+func saveValidationErrorsToCtx(ctx *gin.Context, errs []ValidationChainError) {
+	if ctx == nil {
+		return
+	}
 
-		ctx.Set(GinValidatorCtxErrorsStoreName, store)
+	result, err := resultFromCtx(ctx)
+	if err != nil {
+		result = &RequestResult{}
+		ctx.Set(GinValidatorCtxErrorsStoreName, result)
 	}
+
+	result.add(errs)
 }
 
 // HasErrors returns true if there are any validation errors in the context.
 func HasErrors(ctx *gin.Context) bool {
-	errs, err := ValidationResult(ctx)
-	return err == nil && len(errs) > 0
+	return !Result(ctx).IsEmpty()
 }
 
 // FirstError returns the first validation error, or nil if there are none.
 func FirstError(ctx *gin.Context) *ValidationChainError {
-	errs, err := ValidationResult(ctx)
-	if err != nil || len(errs) == 0 {
+	errs := Result(ctx).Errors()
+	if len(errs) == 0 {
 		return nil
 	}
 	return &errs[0]
@@ -170,16 +281,14 @@ func ErrorsByField(ctx *gin.Context) (map[string][]ValidationChainError, error)
 
 // FirstErrorByField returns at most one error per field.
 func FirstErrorByField(ctx *gin.Context) (map[string]ValidationChainError, error) {
-	errs, err := ValidationResult(ctx)
+	result, err := resultFromCtx(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	firsts := make(map[string]ValidationChainError)
-	for _, e := range errs {
-		if _, exists := firsts[e.Field]; !exists {
-			firsts[e.Field] = e
-		}
+	for field, first := range result.FormatWith(nil).Mapped() {
+		firsts[field] = first.(ValidationChainError)
 	}
 	return firsts, nil
 }
@@ -463,3 +463,86 @@ func TestFirstErrorByField(t *testing.T) {
 		t.Errorf("expected first name error msg 'err3', got %q", firsts["name"].Message)
 	}
 }
+
+func TestResult(t *testing.T) {
+	emailErr1 := newValidationChainError(vceWithField("email"), vceWithMessage("err1"), vceWithLocation("body"), vceWithValue("x"), vceWithOrder(1))
+	emailErr2 := newValidationChainError(vceWithField("email"), vceWithMessage("err2"), vceWithLocation("body"), vceWithValue("x"), vceWithOrder(2))
+	pageErr := newValidationChainError(vceWithField("page"), vceWithMessage("err3"), vceWithLocation("queries"), vceWithValue("y"), vceWithOrder(3))
+
+	newCtx := func() *gin.Context {
+		ctx := createTestGinCtx(ginCtxReqOpts{})
+		saveValidationErrorsToCtx(ctx, []ValidationChainError{emailErr1, emailErr2})
+		saveValidationErrorsToCtx(ctx, []ValidationChainError{pageErr})
+		return ctx
+	}
+
+	message := func(err ValidationChainError) any {
+		return err.Message
+	}
+
+	t.Run("Errors are ordered within each request.", func(t *testing.T) {
+		for range 2 {
+			want := []ValidationChainError{emailErr1, emailErr2, pageErr}
+			if got := Result(newCtx()).Errors(); !slices.Equal(got, want) {
+				t.Errorf("got %+v, want %+v", got, want)
+			}
+		}
+	})
+
+	t.Run("A request without result is empty.", func(t *testing.T) {
+		for _, ctx := range []*gin.Context{nil, createTestGinCtx(ginCtxReqOpts{})} {
+			result := Result(ctx)
+			if !result.IsEmpty() || len(result.Array(false)) != 0 || len(result.Mapped()) != 0 || result.Throw() != nil {
+				t.Errorf("got a non empty result %+v", result)
+			}
+		}
+	})
+
+	t.Run("IsEmpty", func(t *testing.T) {
+		if Result(newCtx()).IsEmpty() {
+			t.Error("expected false, got true")
+		}
+	})
+
+	t.Run("Array", func(t *testing.T) {
+		result := Result(newCtx()).FormatWith(message)
+
+		if got, want := result.Array(false), []any{"err1", "err2", "err3"}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+		if got, want := result.Array(true), []any{"err1", "err3"}; !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Mapped", func(t *testing.T) {
+		got := Result(newCtx()).FormatWith(message).Mapped()
+		want := map[string]any{"email": "err1", "page": "err3"}
+
+		if !cmp.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("FormatWith leaves the stored result unchanged.", func(t *testing.T) {
+		ctx := newCtx()
+		Result(ctx).FormatWith(message)
+
+		if got := Result(ctx).Array(false)[0]; got != any(emailErr1) {
+			t.Errorf("got %v, want %v", got, emailErr1)
+		}
+	})
+
+	t.Run("Throw", func(t *testing.T) {
+		err := Result(newCtx()).Throw()
+
+		var resultErr *ResultError
+		if !errors.As(err, &resultErr) || len(resultErr.Errors) != 3 {
+			t.Fatalf("got %v, want a *ResultError with 3 errors", err)
+		}
+
+		if want := `validation failed: body "email": err1 (and 2 more errors)`; err.Error() != want {
+			t.Errorf("got %q, want %q", err.Error(), want)
+		}
+	})
+}
@@ -1,14 +1,17 @@
 package ginvalidator
 
 import (
+	"errors"
 	"net/http"
 
 	vgo "github.com/bube054/validatorgo"
 	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
 )
 
 const (
 	CustomValidatorName             string = "CustomValidator"
+	CustomValidatorCtxName          string = "CustomValidatorCtx"
 	ContainsValidatorName           string = "Contains"
 	EqualsValidatorName             string = "Equals"
 	AbaRoutingValidatorName         string = "AbaRouting"
@@ -101,6 +104,14 @@ const (
 	VariableWidthValidatorName      string = "VariableWidth"
 	WhitelistedValidatorName        string = "Whitelisted"
 	MatchesValidatorName            string = "Matches"
+	ItemCountValidatorName          string = "ItemCount"
+	UniqueItemsValidatorName        string = "UniqueItems"
+	EqualsFieldValidatorName        string = "EqualsField"
+	AfterFieldValidatorName         string = "AfterField"
+	BeforeFieldValidatorName        string = "BeforeField"
+	RequiredIfValidatorName         string = "RequiredIf"
+	RequiredWithoutValidatorName    string = "RequiredWithout"
+	ExistsValidatorName             string = "Exists"
 )
 
 // A validator is simply a piece of the validation chain that can validate values from the specified field.
@@ -108,8 +119,10 @@ type validator struct {
 	field      string            // the field to be specified
 	errFmtFunc ErrFmtFunc // the function to create the error message
 
-	reqLoc            RequestLocation  // the HTTP request location (e.g., body, headers, cookies, params, or queries)
-	rulesCreatorFuncs ruleCreatorFuncs // the list of functions that creates the validation rules.
+	reqLoc            RequestLocation   // the HTTP request location (e.g., body, headers, cookies, params, or queries)
+	locations         []RequestLocation // the locations searched, in order, by a chain created with NewCheck; nil for any other chain
+	rulesCreatorFuncs ruleCreatorFuncs  // the list of functions that creates the validation rules.
+	ruleDescriptors   ruleDescriptors   // the descriptors of the validation rules, in the same order.
 }
 
 // newValidator creates and returns a new validator.
@@ -127,27 +140,47 @@ func newValidator(field string, errFmtFunc ErrFmtFunc, reqLoc RequestLocation) v
 }
 
 // recreateValidationChainFromValidator takes the previous validator and returns a new validation chain.
-func (v *validator) recreateValidationChainFromValidator(ruleCreatorFunc ruleCreatorFunc) ValidationChain {
-	newRulesCreatorFunc := append(v.rulesCreatorFuncs, ruleCreatorFunc)
+// The name and params of the validator are used in error messages.
+func (v *validator) recreateValidationChainFromValidator(ruleCreatorFunc ruleCreatorFunc, name string, params map[string]any) ValidationChain {
+	return v.recreateValidationChainFromRule(ruleCreatorFunc, ruleDescriptor{chainType: validatorType, name: name, params: params})
+}
+
+// recreateValidationChainFromArrayValidator takes the previous validator and returns a new validation chain
+// whose last validator checks all the values of a multi-value field at once.
+func (v *validator) recreateValidationChainFromArrayValidator(ruleCreatorFunc ruleCreatorFunc, name string, params map[string]any) ValidationChain {
+	return v.recreateValidationChainFromRule(ruleCreatorFunc, ruleDescriptor{chainType: validatorType, arrayLevel: true, name: name, params: params})
+}
+
+// recreateValidationChainFromRule takes the previous validator and returns a new validation chain ending with the rule.
+func (v *validator) recreateValidationChainFromRule(ruleCreatorFunc ruleCreatorFunc, descriptor ruleDescriptor) ValidationChain {
+	// Cap the slices so that chains sharing a common prefix never overwrite each other's rules.
+	newRulesCreatorFunc := append(v.rulesCreatorFuncs[:len(v.rulesCreatorFuncs):len(v.rulesCreatorFuncs)], ruleCreatorFunc)
+	newRuleDescriptors := append(v.ruleDescriptors[:len(v.ruleDescriptors):len(v.ruleDescriptors)], descriptor)
 
 	return ValidationChain{
 		validator: validator{
 			field:             v.field,
 			reqLoc:            v.reqLoc,
+			locations:         v.locations,
 			errFmtFunc:        v.errFmtFunc,
 			rulesCreatorFuncs: newRulesCreatorFunc,
+			ruleDescriptors:   newRuleDescriptors,
 		},
 		modifier: modifier{
 			field:             v.field,
 			reqLoc:            v.reqLoc,
+			locations:         v.locations,
 			errFmtFunc:        v.errFmtFunc,
 			rulesCreatorFuncs: newRulesCreatorFunc,
+			ruleDescriptors:   newRuleDescriptors,
 		},
 		sanitizer: sanitizer{
 			field:             v.field,
 			reqLoc:            v.reqLoc,
+			locations:         v.locations,
 			errFmtFunc:        v.errFmtFunc,
 			rulesCreatorFuncs: newRulesCreatorFunc,
+			ruleDescriptors:   newRuleDescriptors,
 		},
 	}
 }
@@ -177,7 +210,110 @@ func (v validator) CustomValidator(cvf CustomValidatorFunc) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, CustomValidatorName, newRuleParams(nil))
+}
+
+// CustomValidatorCtxFunc defines a function that validates the value according to your custom logic,
+// with access to the Gin context, e.g. to look the value up in a database with ctx.Request.Context().
+//
+// The returned error decides the outcome:
+//   - nil: the value is valid.
+//   - an error created with [NewValidationFailure] (or wrapping a [vgo.ValidationError]): the value is invalid,
+//     and the error's message and code are reported in the [ValidationChainError].
+//   - any other error: the validator could not decide, e.g. because the lookup failed or the request was canceled.
+//     It is reported as an [InternalValidationError] to [InternalErrorHandler] instead of a validation error,
+//     and the rest of the chain does not run.
+//
+// Parameters:
+//   - ctx: The Gin context of the request.
+//   - initialValue: The original value derived from the specified field.
+//   - sanitizedValue: The current sanitized value after applying previous sanitizers.
+type CustomValidatorCtxFunc func(ctx *gin.Context, initialValue, sanitizedValue string) error
+
+// NewValidationFailure returns the error a [CustomValidatorCtxFunc] returns for an invalid value.
+// The message and code are reported in the [ValidationChainError], unless a message is set for the validator
+// or chain; code can be "".
+func NewValidationFailure(message, code string) error {
+	return &vgo.ValidationError{Validator: CustomValidatorCtxName, Message: message, Code: code}
+}
+
+// CustomValidatorCtx applies a context-aware custom validator function that can report why a value is invalid,
+// and tell invalid values apart from failures to validate them.
+//
+// Example:
+//
+//	ginvalidator.NewBodyChain("email", nil).CustomValidatorCtx(func(ctx *gin.Context, initialValue, sanitizedValue string) error {
+//	  taken, err := users.EmailExists(ctx.Request.Context(), sanitizedValue)
+//	  if err != nil {
+//	    return err // reported to InternalErrorHandler, 500 by default
+//	  }
+//	  if taken {
+//	    return ginvalidator.NewValidationFailure("email already in use", "email_taken")
+//	  }
+//	  return nil
+//	})
+//
+// Parameters:
+//   - cvf: The [CustomValidatorCtxFunc] used to evaluate the validity.
+func (v validator) CustomValidatorCtx(cvf CustomValidatorCtxFunc) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		err := cvf(ctx, initialValue, sanitizedValue)
+
+		var (
+			ve          *vgo.ValidationError
+			vErr        error
+			internalErr error
+		)
+
+		switch {
+		case err == nil:
+		case errors.As(err, &ve):
+			vErr = ve
+		default:
+			internalErr = err
+		}
+
+		return newValidationChainRule(
+			withIsValid(err == nil),
+			withNewValue(sanitizedValue),
+			withValidationChainName(CustomValidatorCtxName),
+			withValidationChainType(validatorType),
+			withValidationErr(vErr),
+			withInternalErr(internalErr),
+		)
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, CustomValidatorCtxName, newRuleParams(nil))
+}
+
+// Exists is a validator that checks if the field is present in the request.
+// An empty value, such as `"field": ""` in a JSON body or an empty header, exists.
+//
+// Use [validator.ExistsFor] to also reject JSON nulls, empty values, zeros or false.
+//
+// Example:
+//
+//	ginvalidator.NewBodyChain("name", nil).Exists()
+func (v validator) Exists() ValidationChain {
+	return v.ExistsFor(AbsentMissing)
+}
+
+// ExistsFor is a validator that checks if the field is present in the request and its value is not one of the absent values,
+// e.g. ExistsFor(ginvalidator.AbsentNull) also rejects `"field": null`. A missing field is always absent.
+func (v validator) ExistsFor(values AbsentValues) ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		// The chain decides if the field exists, because only it knows how the value was sent.
+		return newValidationChainRule(
+			withIsValid(true),
+			withNewValue(sanitizedValue),
+			withValidationChainName(ExistsValidatorName),
+			withValidationChainType(validatorType),
+			withValidationErr(&vgo.ValidationError{Validator: ExistsValidatorName, Code: RequiredCode, Message: v.field + " is required"}),
+			withAbsentValues(values),
+		)
+	}
+
+	return v.recreateValidationChainFromValidator(ruleCreator, ExistsValidatorName, newRuleParams(nil, "absentValues", values.String()))
 }
 
 // Contains is a validator that checks if the string contains the seed.
@@ -200,7 +336,7 @@ func (v validator) Contains(seed string, opts *vgo.ContainsOpt) ValidationChain
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ContainsValidatorName, newRuleParams(opts, "seed", seed))
 }
 
 // Equals is a validator that checks if the string contains the seed.
@@ -223,5 +359,83 @@ func (v validator) Equals(comparison string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, EqualsValidatorName, newRuleParams(nil, "comparison", comparison))
+}
+
+// ItemCountOpts defines the number of items a value must have for [validator.ItemCount].
+type ItemCountOpts struct {
+	Min int  // the minimum number of items
+	Max *int // the maximum number of items, or nil for no maximum
+}
+
+// ItemCount is an array-level validator that checks if a value is a JSON array with a number of items within the given range.
+//
+// For query, header and form fields, which can be sent several times, it checks all the values at once,
+// so it limits how many times the field is sent.
+//
+// Example:
+//
+//	ginvalidator.NewQueryChain("tag", nil).ItemCount(&ginvalidator.ItemCountOpts{Min: 1, Max: vgo.Int(5)})
+func (v validator) ItemCount(opts *ItemCountOpts) ValidationChain {
+	if opts == nil {
+		opts = &ItemCountOpts{}
+	}
+
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		items, ok := jsonArrayItems(sanitizedValue)
+		count := len(items)
+		isValid := ok && count >= opts.Min && (opts.Max == nil || count <= *opts.Max)
+
+		return newValidationChainRule(
+			withIsValid(isValid),
+			withNewValue(sanitizedValue),
+			withValidationChainName(ItemCountValidatorName),
+			withValidationChainType(validatorType),
+		)
+	}
+
+	return v.recreateValidationChainFromArrayValidator(ruleCreator, ItemCountValidatorName, newRuleParams(opts))
+}
+
+// UniqueItems is an array-level validator that checks if a value is a JSON array whose items are all different.
+// Items are compared by their JSON encoding.
+//
+// For query, header and form fields, which can be sent several times, it checks all the values at once,
+// so it rejects a value that is sent twice.
+func (v validator) UniqueItems() ValidationChain {
+	var ruleCreator ruleCreatorFunc = func(ctx *gin.Context, initialValue, sanitizedValue string) validationChainRule {
+		items, isValid := jsonArrayItems(sanitizedValue)
+		seen := make(map[string]bool, len(items))
+
+		for _, item := range items {
+			if seen[item.Raw] {
+				isValid = false
+				break
+			}
+			seen[item.Raw] = true
+		}
+
+		return newValidationChainRule(
+			withIsValid(isValid),
+			withNewValue(sanitizedValue),
+			withValidationChainName(UniqueItemsValidatorName),
+			withValidationChainType(validatorType),
+		)
+	}
+
+	return v.recreateValidationChainFromArrayValidator(ruleCreator, UniqueItemsValidatorName, newRuleParams(nil))
+}
+
+// jsonArrayItems returns the items of a JSON array, and false if the value is not a JSON array.
+func jsonArrayItems(value string) ([]gjson.Result, bool) {
+	if !gjson.Valid(value) {
+		return nil, false
+	}
+
+	result := gjson.Parse(value)
+	if !result.IsArray() {
+		return nil, false
+	}
+
+	return result.Array(), true
 }
@@ -26,7 +26,7 @@ func (v validator) AbaRouting() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, AbaRoutingValidatorName, newRuleParams(nil))
 }
 
 // After is a validator that checks if the string is a date that is after the specified date.
@@ -48,7 +48,7 @@ func (v validator) After(opts *vgo.IsAfterOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, AfterValidatorName, newRuleParams(opts))
 }
 
 // Alpha is a validator that checks if the string contains only letters (a-zA-Z).
@@ -70,7 +70,7 @@ func (v validator) Alpha(opts *vgo.IsAlphaOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, AlphaValidatorName, newRuleParams(opts))
 }
 
 // Alphanumeric is a validator that checks if the string contains only letters and numbers (a-zA-Z0-9).
@@ -92,13 +92,16 @@ func (v validator) Alphanumeric(opts *vgo.IsAlphanumericOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, AlphanumericValidatorName, newRuleParams(opts))
 }
 
-// Base32 is a validator to check that a value is an array.
+// Array is a validator to check that a value is an array.
 //
 // This function uses the [IsArray] from [validatorgo] package to perform the validation logic.
 //
+// It is an array-level validator: for query, header and form fields, which can be sent several times,
+// it checks all the values at once, encoded as a JSON array of strings.
+//
 // [validatorgo]: https://pkg.go.dev/github.com/bube054
 // [IsArray]: https://pkg.go.dev/github.com/bube054/validatorgo#IsArray
 func (v validator) Array(opts *vgo.IsArrayOpts) ValidationChain {
@@ -114,7 +117,7 @@ func (v validator) Array(opts *vgo.IsArrayOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromArrayValidator(ruleCreator, ArrayValidatorName, newRuleParams(opts))
 }
 
 // Ascii is a validator that checks if the string contains ASCII chars only.
@@ -130,13 +133,13 @@ func (v validator) Ascii() ValidationChain {
 		return newValidationChainRule(
 			withIsValid(isValid),
 			withNewValue(sanitizedValue),
-			withValidationChainName(AbaRoutingValidatorName),
+			withValidationChainName(AsciiValidatorName),
 			withValidationChainType(validatorType),
 			withValidationErr(vErr),
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, AsciiValidatorName, newRuleParams(nil))
 }
 
 // Base32 is a validator that checks if the string is base32 encoded.
@@ -158,7 +161,7 @@ func (v validator) Base32(opts *vgo.IsBase32Opts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, Base32ValidatorName, newRuleParams(opts))
 }
 
 // Base58 is a validator that checks if the string is base32 encoded.
@@ -180,7 +183,7 @@ func (v validator) Base58() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, Base58ValidatorName, newRuleParams(nil))
 }
 
 // Base64 is a validator that checks if the string is base64 encoded.
@@ -202,7 +205,7 @@ func (v validator) Base64(opts *vgo.IsBase64Opts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, Base64ValidatorName, newRuleParams(opts))
 }
 
 // Before is a validator that checks if the string is a date that is before the specified date.
@@ -224,7 +227,7 @@ func (v validator) Before(opts *vgo.IsBeforeOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, BeforeValidatorName, newRuleParams(opts))
 }
 
 // Bic is a validator that checks if the string is a BIC (Bank Identification Code) or SWIFT code.
@@ -246,7 +249,7 @@ func (v validator) Bic() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, BicValidatorName, newRuleParams(nil))
 }
 
 // Boolean validator that checks if the string is a boolean.
@@ -268,7 +271,7 @@ func (v validator) Boolean(opts *vgo.IsBooleanOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, BooleanValidatorName, newRuleParams(opts))
 }
 
 // BTCAddress is a validator that checks if the string is a valid BTC address.
@@ -290,7 +293,7 @@ func (v validator) BTCAddress() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, BTCAddressValidatorName, newRuleParams(nil))
 }
 
 // ByteLength is a validator that checks if the string's length (in UTF-8 bytes) falls in a range.
@@ -312,7 +315,7 @@ func (v validator) ByteLength(opts *vgo.IsByteLengthOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ByteLengthValidatorName, newRuleParams(opts))
 }
 
 // CreditCard is a validator that checks if the string is a credit card number.
@@ -334,7 +337,7 @@ func (v validator) CreditCard(opts *vgo.IsCreditCardOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, CreditCardValidatorName, newRuleParams(opts))
 }
 
 // Currency is a validator that checks if the string is a valid currency amount.
@@ -356,7 +359,7 @@ func (v validator) Currency(opts *vgo.IsCurrencyOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, CurrencyValidatorName, newRuleParams(opts))
 }
 
 // DataURI is a validator that checks if the string is a data uri format.
@@ -378,7 +381,7 @@ func (v validator) DataURI() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, DataURIValidatorName, newRuleParams(nil))
 }
 
 // Date is a validator that checks if the string is a valid date. e.g. 2002-07-15.
@@ -394,13 +397,13 @@ func (v validator) Date(opts *vgo.IsDateOpts) ValidationChain {
 		return newValidationChainRule(
 			withIsValid(isValid),
 			withNewValue(sanitizedValue),
-			withValidationChainName(DataURIValidatorName),
+			withValidationChainName(DateValidatorName),
 			withValidationChainType(validatorType),
 			withValidationErr(vErr),
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, DateValidatorName, newRuleParams(opts))
 }
 
 // Decimal is a validator that checks if the string represents a decimal number, such as 0.1, .3, 1.1, 1.00003, 4.0, etc.
@@ -422,7 +425,7 @@ func (v validator) Decimal(opts *vgo.IsDecimalOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, DecimalValidatorName, newRuleParams(opts))
 }
 
 // DivisibleBy is a validator thats checks if the string is a number(integer not a floating point) that is divisible by another(integer not a floating point).
@@ -444,5 +447,5 @@ func (v validator) DivisibleBy(num int) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, DivisibleByValidatorName, newRuleParams(nil, "num", num))
 }
@@ -26,7 +26,7 @@ func (v validator) EAN() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, EANValidatorName, newRuleParams(nil))
 }
 
 // Email is a validator that checks if the string is an email.
@@ -48,7 +48,7 @@ func (v validator) Email(opts *vgo.IsEmailOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, EmailValidatorName, newRuleParams(opts))
 }
 
 // Empty is a validator that checks if the string is an email.
@@ -70,7 +70,7 @@ func (v validator) Empty(opts *vgo.IsEmptyOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, EmptyValidatorName, newRuleParams(opts))
 }
 
 // EthereumAddress is a validator checks if the string is an Ethereum address.
@@ -92,7 +92,7 @@ func (v validator) EthereumAddress() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, EthereumAddressValidatorName, newRuleParams(nil))
 }
 
 // Float is a validator that checks if the string is a float.
@@ -114,7 +114,7 @@ func (v validator) Float(opts *vgo.IsFloatOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, FloatValidatorName, newRuleParams(opts))
 }
 
 // FQDN is a validator that checks if the string is a fully qualified domain name (e.g. domain.com).
@@ -136,7 +136,7 @@ func (v validator) FQDN(opts *vgo.IsFQDNOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, FQDNValidatorName, newRuleParams(opts))
 }
 
 // FreightContainerID is a validator that checks alias for IsISO6346, check if the string is a valid ISO 6346 shipping container identification.
@@ -158,7 +158,7 @@ func (v validator) FreightContainerID() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, FreightContainerIDValidatorName, newRuleParams(nil))
 }
 
 // FullWidth validator that checks if the string contains any full-width chars.
@@ -180,7 +180,7 @@ func (v validator) FullWidth() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, FullWidthValidatorName, newRuleParams(nil))
 }
 
 // HalfWidth is a validator that checks if the string contains any half-width chars.
@@ -202,7 +202,7 @@ func (v validator) HalfWidth() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, HalfWidthValidatorName, newRuleParams(nil))
 }
 
 // Hash is a validator that checks if the string is a hash of type algorithm.
@@ -224,7 +224,7 @@ func (v validator) Hash(algorithm string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, HashValidatorName, newRuleParams(nil, "algorithm", algorithm))
 }
 
 // Hexadecimal is a validator that checks if the string is a hexadecimal number.
@@ -246,7 +246,7 @@ func (v validator) Hexadecimal() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, HexadecimalValidatorName, newRuleParams(nil))
 }
 
 // HexColor is a validator that checks if the string is a hexadecimal color.
@@ -268,7 +268,7 @@ func (v validator) HexColor() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, HexColorValidatorName, newRuleParams(nil))
 }
 
 // HSL is a validator that checks if the string is an HSL (hue, saturation, lightness, optional alpha) color based on CSS Colors Level 4 specification.
@@ -290,7 +290,7 @@ func (v validator) HSL() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, HSLValidatorName, newRuleParams(nil))
 }
 
 // IBAN is a validator that checks if the string is an IBAN (International Bank Account Number).
@@ -312,7 +312,7 @@ func (v validator) IBAN(countryCode string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, IBANValidatorName, newRuleParams(nil, "countryCode", countryCode))
 }
 
 // IdentityCard is a validator that checks if the string is a valid identity card code.
@@ -334,7 +334,7 @@ func (v validator) IdentityCard(locale string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, IdentityCardValidatorName, newRuleParams(nil, "locale", locale))
 }
 
 // IMEI is a validator that checks if the string is a valid IMEI number.
@@ -356,7 +356,7 @@ func (v validator) IMEI(opts *vgo.IsIMEIOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, IMEIValidatorName, newRuleParams(opts))
 }
 
 // In is a validator that checks if the string is in a slice of allowed values.
@@ -378,7 +378,7 @@ func (v validator) In(values []string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, InValidatorName, newRuleParams(nil, "values", values))
 }
 
 // Int is a validator that checks if the string is an integer.
@@ -400,7 +400,7 @@ func (v validator) Int(opts *vgo.IsIntOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, IntValidatorName, newRuleParams(opts))
 }
 
 // IP is a validator that checks if the string is an IP (version 4 or 6).
@@ -422,7 +422,7 @@ func (v validator) IP(version string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, IPValidatorName, newRuleParams(nil, "version", version))
 }
 
 // IPRange is a validator that checks if the string is an IPRange (version 4 or 6).
@@ -444,6 +444,6 @@ func (v validator) IPRange(version string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, IPRangeValidatorName, newRuleParams(nil, "version", version))
 }
 
@@ -20,13 +20,13 @@ func (v validator) ISIN() ValidationChain {
 		return newValidationChainRule(
 			withIsValid(isValid),
 			withNewValue(sanitizedValue),
-			withValidationChainName(InValidatorName),
+			withValidationChainName(ISINValidatorName),
 			withValidationChainType(validatorType),
 			withValidationErr(vErr),
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISINValidatorName, newRuleParams(nil))
 }
 
 // ISO4217 is a validator that checks if the string is a valid ISO 4217 officially assigned.
@@ -48,7 +48,7 @@ func (v validator) ISO4217() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISO4217ValidatorName, newRuleParams(nil))
 }
 
 // ISO6346 is a validator that checks if the string is a valid ISO 6346 shipping container identification.
@@ -70,7 +70,7 @@ func (v validator) ISO6346() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISO6346ValidatorName, newRuleParams(nil))
 }
 
 // ISO6391 is a validator that checks if the string is a valid ISO 639-1 language code.
@@ -92,7 +92,7 @@ func (v validator) ISO6391() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISO6391ValidatorName, newRuleParams(nil))
 }
 
 // ISO8601 is a validator that checks if the string is a valid ISO 8601 date.
@@ -114,7 +114,7 @@ func (v validator) ISO8601(opts *vgo.IsISO8601Opts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISO8601ValidatorName, newRuleParams(opts))
 }
 
 // ISO31661Alpha2 is a validator that checks if the string is a valid ISO 3166-1 alpha-2 officially assigned country code.
@@ -136,7 +136,7 @@ func (v validator) ISO31661Alpha2() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISO31661Alpha2ValidatorName, newRuleParams(nil))
 }
 
 // ISO31661Alpha3 is a validator that checks if the string is a valid ISO 3166-1 alpha-2 officially assigned country code.
@@ -158,7 +158,7 @@ func (v validator) ISO31661Alpha3() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISO31661Alpha3ValidatorName, newRuleParams(nil))
 }
 
 // ISO31661Numeric is a validator that checks check if the string is a valid ISO 3166-1 numeric officially assigned country code.
@@ -180,7 +180,7 @@ func (v validator) ISO31661Numeric() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISO31661NumericValidatorName, newRuleParams(nil))
 }
 
 // ISRC is a validator that checks if the string is an ISRC.
@@ -202,7 +202,7 @@ func (v validator) ISRC(allowHyphens bool) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISRCValidatorName, newRuleParams(nil, "allowHyphens", allowHyphens))
 }
 
 // ISSN is a validator that checks if the string is an ISSN.
@@ -224,7 +224,7 @@ func (v validator) ISSN(opts *vgo.IsISSNOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ISSNValidatorName, newRuleParams(opts))
 }
 
 // JSON is a validator that checks if the string is an JSON.
@@ -246,7 +246,7 @@ func (v validator) JSON() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, JSONValidatorName, newRuleParams(nil))
 }
 
 // LatLong is a validator that checks if the string is a valid latitude-longitude coordinate.
@@ -268,7 +268,7 @@ func (v validator) LatLong(opts *vgo.IsLatLongOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, LatLongValidatorName, newRuleParams(opts))
 }
 
 // length is a validator that checks if the string's length falls in a range.
@@ -290,7 +290,7 @@ func (v validator) Length(opts *vgo.IsLengthOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, LengthValidatorName, newRuleParams(opts))
 }
 
 // LicensePlate is a validator that checks if the string matches the format of a country's license plate.
@@ -312,7 +312,7 @@ func (v validator) LicensePlate(locale string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, LicensePlateValidatorName, newRuleParams(nil, "locale", locale))
 }
 
 // Locale is a validator that checks if the string is a locale.
@@ -334,7 +334,7 @@ func (v validator) Locale() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, LocaleValidatorName, newRuleParams(nil))
 }
 
 // LowerCase is a validator that checks if the string is lowercase.
@@ -356,7 +356,7 @@ func (v validator) LowerCase() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, LowerCaseValidatorName, newRuleParams(nil))
 }
 
 // LuhnNumber is a validator that checks if the string passes the Luhn algorithm check.
@@ -378,7 +378,7 @@ func (v validator) LuhnNumber() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, LuhnNumberValidatorName, newRuleParams(nil))
 }
 
 // MacAddress is a validator that checks if the string is a MAC address.
@@ -400,7 +400,7 @@ func (v validator) MacAddress(opts *vgo.IsMacAddressOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, MacAddressValidatorName, newRuleParams(opts))
 }
 
 // MagnetURI is a validator that checks if the string is a Magnet URI format.
@@ -422,7 +422,7 @@ func (v validator) MagnetURI() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, MagnetURIValidatorName, newRuleParams(nil))
 }
 
 // MailtoURI is a validator that checks if the string is a Mailto URI format.
@@ -444,7 +444,7 @@ func (v validator) MailtoURI(opts *vgo.IsMailToURIOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, MailtoURIValidatorName, newRuleParams(opts))
 }
 
 // MD5 is a validator that checks if the string is a MD5 hash.
@@ -466,7 +466,7 @@ func (v validator) MD5() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, MD5ValidatorName, newRuleParams(nil))
 }
 
 // MimeType is a validator that checks if the string matches to a valid MIME type format.
@@ -488,7 +488,7 @@ func (v validator) MimeType() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, MimeTypeValidatorName, newRuleParams(nil))
 }
 
 // MobilePhone is a validator that checks if the string is a mobile phone number.
@@ -510,7 +510,7 @@ func (v validator) MobilePhone(locales []string, opts *vgo.IsMobilePhoneOpts) Va
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, MobilePhoneValidatorName, newRuleParams(opts, "locales", locales))
 }
 
 // MongoID is a validator that checks if the string is a valid hex-encoded representation of a MongoDB ObjectId.
@@ -532,7 +532,7 @@ func (v validator) MongoID() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, MongoIDValidatorName, newRuleParams(nil))
 }
 
 // Multibyte is a validator that checks if the string contains one or more multibyte chars.
@@ -554,5 +554,5 @@ func (v validator) Multibyte() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, MultibyteValidatorName, newRuleParams(nil))
 }
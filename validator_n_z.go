@@ -28,10 +28,10 @@ func (v validator) Numeric(opts *vgo.IsNumericOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, NumericValidatorName, newRuleParams(opts))
 }
 
-// Octal is a  validator to check that a value is a json object.
+// Object is a validator to check that a value is a json object.
 //
 // This function uses the [IsObject] from [validatorgo] package to perform the validation logic.
 //
@@ -44,13 +44,13 @@ func (v validator) Object(opts *vgo.IsObjectOpts) ValidationChain {
 		return newValidationChainRule(
 			withIsValid(isValid),
 			withNewValue(sanitizedValue),
-			withValidationChainName(OctalValidatorName),
+			withValidationChainName(ObjectValidatorName),
 			withValidationChainType(validatorType),
 			withValidationErr(vErr),
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ObjectValidatorName, newRuleParams(opts))
 }
 
 // Octal is a validator that checks if the string is a valid octal number.
@@ -72,7 +72,7 @@ func (v validator) Octal() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, OctalValidatorName, newRuleParams(nil))
 }
 
 // PassportNumber is a validator that checks if the string is a valid passport number.
@@ -94,7 +94,7 @@ func (v validator) PassportNumber(countryCode string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, PassportNumberValidatorName, newRuleParams(nil, "countryCode", countryCode))
 }
 
 // Port is a validator that checks if the string is a valid port number.
@@ -116,7 +116,7 @@ func (v validator) Port() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, PortValidatorName, newRuleParams(nil))
 }
 
 // PostalCode is a validator that checks if the string is a postal code.
@@ -138,7 +138,7 @@ func (v validator) PostalCode(locale string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, PostalCodeValidatorName, newRuleParams(nil, "locale", locale))
 }
 
 // RFC3339 is a validator that checks if the string is a valid RFC 3339 date.
@@ -160,7 +160,7 @@ func (v validator) RFC3339() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, RFC3339ValidatorName, newRuleParams(nil))
 }
 
 // RgbColor is a validator that checks if the string is a rgb or rgba color.
@@ -182,7 +182,7 @@ func (v validator) RgbColor(opts *vgo.IsRgbOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, RgbColorValidatorName, newRuleParams(opts))
 }
 
 // SemVer is a validator that checks if the string is a Semantic Versioning Specification (SemVer).
@@ -204,7 +204,7 @@ func (v validator) SemVer() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, SemVerValidatorName, newRuleParams(nil))
 }
 
 // Slug is a validator that checks if the string is of type slug.
@@ -226,7 +226,7 @@ func (v validator) Slug() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, SlugValidatorName, newRuleParams(nil))
 }
 
 // StrongPassword is a validator that checks if the string is of type strongPassword.
@@ -248,7 +248,7 @@ func (v validator) StrongPassword(opts *vgo.IsStrongPasswordOpts) ValidationChai
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, StrongPasswordValidatorName, newRuleParams(opts))
 }
 
 // TaxID is a validator that checks if the string is a valid Tax Identification Number.
@@ -270,7 +270,7 @@ func (v validator) TaxID(locale string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, TaxIDValidatorName, newRuleParams(nil, "locale", locale))
 }
 
 // SurrogatePair is a validator that checks if the string contains any surrogate pairs chars.
@@ -292,7 +292,7 @@ func (v validator) SurrogatePair() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, SurrogatePairValidatorName, newRuleParams(nil))
 }
 
 // Time is a validator that checks if the string is a valid time e.g. 23:01:59
@@ -314,7 +314,7 @@ func (v validator) Time(opts *vgo.IsTimeOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, TimeValidatorName, newRuleParams(opts))
 }
 
 // ULID is a validator that checks if the string is a ULID.
@@ -336,7 +336,7 @@ func (v validator) ULID() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, ULIDValidatorName, newRuleParams(nil))
 }
 
 // UpperCase is a validator that checks if the string is uppercase.
@@ -358,7 +358,7 @@ func (v validator) UpperCase() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, UpperCaseValidatorName, newRuleParams(nil))
 }
 
 // URL is a validator that checks if the string is URL.
@@ -380,7 +380,7 @@ func (v validator) URL(opts *vgo.IsURLOpts) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, URLValidatorName, newRuleParams(opts))
 }
 
 // UUID is a validator that checks if the string is an RFC9562 UUID.
@@ -402,7 +402,7 @@ func (v validator) UUID(version string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, UUIDValidatorName, newRuleParams(nil, "version", version))
 }
 
 // VariableWidth is a validator that checks if the string contains a mixture of full and half-width chars.
@@ -424,7 +424,7 @@ func (v validator) VariableWidth() ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, VariableWidthValidatorName, newRuleParams(nil))
 }
 
 // VAT is a validator that checks if the string is a valid VAT.
@@ -446,7 +446,7 @@ func (v validator) VAT(countryCode string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, VATValidatorName, newRuleParams(nil, "countryCode", countryCode))
 }
 
 // Whitelisted is a validator that checks if the string consists only of characters that appear in the whitelist chars.
@@ -468,7 +468,7 @@ func (v validator) Whitelisted(chars string) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, WhitelistedValidatorName, newRuleParams(nil, "chars", chars))
 }
 
 // Matches is a validator that checks if the string matches the regex.
@@ -490,5 +490,5 @@ func (v validator) Matches(re *regexp.Regexp) ValidationChain {
 		)
 	}
 
-	return v.recreateValidationChainFromValidator(ruleCreator)
+	return v.recreateValidationChainFromValidator(ruleCreator, MatchesValidatorName, newRuleParams(nil, "re", re))
 }
@@ -1,10 +1,17 @@
 package ginvalidator
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	vgo "github.com/bube054/validatorgo"
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestCustomValidator(t *testing.T) {
@@ -66,7 +73,7 @@ func TestCustomValidator(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -76,6 +83,135 @@ func TestCustomValidator(t *testing.T) {
 	}
 }
 
+func TestCustomValidatorCtx(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	errLookup := errors.New("connection refused")
+
+	lookup := func(ctx *gin.Context, initialValue, sanitizedValue string) error {
+		switch sanitizedValue {
+		case "taken@example.com":
+			return NewValidationFailure("email already in use", "email_taken")
+		case "down@example.com":
+			return fmt.Errorf("users lookup: %w", errLookup)
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		body    string
+		handler func(ctx *gin.Context, err *InternalValidationError)
+
+		status   int
+		errs     []ValidationChainError
+		ctxErrs  int
+		handled  bool
+		internal bool
+	}{
+		{
+			name:    "Nil error is valid.",
+			body:    `{"email": "new@example.com"}`,
+			handler: InternalErrorHandler,
+			status:  http.StatusOK,
+			errs:    []ValidationChainError{},
+			handled: true,
+		},
+		{
+			name:    "Validation failure carries its message and code.",
+			body:    `{"email": "taken@example.com"}`,
+			handler: InternalErrorHandler,
+			status:  http.StatusOK,
+			errs: []ValidationChainError{
+				{Location: "body", Field: "email", Value: "taken@example.com", Message: "email already in use", Code: "email_taken"},
+			},
+			handled: true,
+		},
+		{
+			name:     "Infrastructure error aborts with 500 by default.",
+			body:     `{"email": "down@example.com"}`,
+			handler:  InternalErrorHandler,
+			status:   http.StatusInternalServerError,
+			ctxErrs:  1,
+			internal: true,
+		},
+		{
+			name:     "Nil handler records the infrastructure error and continues.",
+			body:     `{"email": "down@example.com"}`,
+			handler:  nil,
+			status:   http.StatusOK,
+			errs:     []ValidationChainError{},
+			ctxErrs:  1,
+			handled:  true,
+			internal: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defaultHandler := InternalErrorHandler
+			InternalErrorHandler = test.handler
+			t.Cleanup(func() { InternalErrorHandler = defaultHandler })
+
+			var (
+				errs    []ValidationChainError
+				ctxErrs []*gin.Error
+				handled bool
+			)
+
+			router := gin.New()
+			router.Use(func(ctx *gin.Context) {
+				ctx.Next()
+				ctxErrs = ctx.Errors
+			})
+			router.POST("/test",
+				NewBodyChain("email", nil).CustomValidatorCtx(lookup).Email(nil).Validate(),
+				func(ctx *gin.Context) {
+					handled = true
+					errs, _ = ValidationResult(ctx)
+				},
+			)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != test.status {
+				t.Errorf("got status %d, want %d", w.Code, test.status)
+			}
+
+			if handled != test.handled {
+				t.Errorf("got handled %t, want %t", handled, test.handled)
+			}
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+
+			if len(ctxErrs) != test.ctxErrs {
+				t.Fatalf("got %d context errors, want %d", len(ctxErrs), test.ctxErrs)
+			}
+
+			if test.internal {
+				var internalErr *InternalValidationError
+				if !errors.As(ctxErrs[0].Err, &internalErr) {
+					t.Fatalf("got context error %v, want an *InternalValidationError", ctxErrs[0].Err)
+				}
+
+				want := InternalValidationError{Location: "body", Field: "email", Validator: CustomValidatorCtxName}
+				if !cmp.Equal(*internalErr, want, cmpopts.IgnoreFields(InternalValidationError{}, "Err")) {
+					t.Errorf("got %+v, want %+v", *internalErr, want)
+				}
+
+				if !errors.Is(internalErr, errLookup) {
+					t.Errorf("got %v, want it to wrap %v", internalErr, errLookup)
+				}
+			}
+		})
+	}
+}
+
 func TestContains(t *testing.T) {
 	tests := []struct {
 		name string
@@ -121,7 +257,7 @@ func TestContains(t *testing.T) {
 
 			ctx := createTestGinCtx(test.reqOpts)
 			vcr := vcrs[0]
-			value, _ := extractFieldValFromBody(ctx, test.field)
+			value, _, _ := extractFieldValFromBody(ctx, test.field)
 			r := vcr(ctx, value, value)
 
 			if r != test.want {
@@ -130,3 +266,136 @@ func TestContains(t *testing.T) {
 		})
 	}
 }
+
+func TestExists(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	isPositive := func(r *http.Request, initialValue, sanitizedValue string) bool {
+		return sanitizedValue != "0"
+	}
+
+	tests := []struct {
+		name    string
+		url     string
+		body    string
+		headers map[string]string
+		chain   ValidationChain
+
+		errs []ValidationChainError
+		md   MatchedData
+	}{
+		{
+			name:  "Missing body field does not exist and is not matched data.",
+			body:  `{}`,
+			chain: NewBodyChain("name", nil).Exists(),
+			errs:  []ValidationChainError{{Location: "body", Field: "name", Value: "", Message: "name is required", Code: RequiredCode}},
+		},
+		{
+			name:  "Empty body field exists.",
+			body:  `{"name": ""}`,
+			chain: NewBodyChain("name", nil).Exists(),
+			errs:  []ValidationChainError{},
+			md:    MatchedData{"body": MatchedDataFieldValues{"name": ""}},
+		},
+		{
+			name:  "Null body field exists.",
+			body:  `{"name": null}`,
+			chain: NewBodyChain("name", nil).Exists(),
+			errs:  []ValidationChainError{},
+			md:    MatchedData{"body": MatchedDataFieldValues{"name": ""}},
+		},
+		{
+			name:  "ExistsFor rejects null.",
+			body:  `{"name": null}`,
+			chain: NewBodyChain("name", nil).ExistsFor(AbsentNull),
+			errs:  []ValidationChainError{{Location: "body", Field: "name", Value: "", Message: "name is required", Code: RequiredCode}},
+			md:    MatchedData{"body": MatchedDataFieldValues{"name": ""}},
+		},
+		{
+			name:  "ExistsFor rejects false when falsy.",
+			body:  `{"accepted": false}`,
+			chain: NewBodyChain("accepted", nil).ExistsFor(AbsentFalsy),
+			errs:  []ValidationChainError{{Location: "body", Field: "accepted", Value: "false", Message: "accepted is required", Code: RequiredCode}},
+			md:    MatchedData{"body": MatchedDataFieldValues{"accepted": "false"}},
+		},
+		{
+			name:    "Empty header exists.",
+			body:    `{}`,
+			headers: map[string]string{"X-Trace": ""},
+			chain:   NewHeaderChain("X-Trace", nil).Exists(),
+			errs:    []ValidationChainError{},
+			md:      MatchedData{"headers": MatchedDataFieldValues{"X-Trace": ""}},
+		},
+		{
+			name:  "Missing query field does not exist.",
+			url:   "/test?other=1",
+			body:  `{}`,
+			chain: NewQueryChain("page", nil).Exists(),
+			errs:  []ValidationChainError{{Location: "queries", Field: "page", Value: "", Message: "page is required", Code: RequiredCode}},
+		},
+		{
+			name:  "Optional skips a missing field.",
+			body:  `{}`,
+			chain: NewBodyChain("age", nil).Optional().Length(&vgo.IsLengthOpts{Min: 1}),
+			errs:  []ValidationChainError{},
+		},
+		{
+			name:  "OptionalFor(AbsentMissing) validates null.",
+			body:  `{"age": null}`,
+			chain: NewBodyChain("age", nil).OptionalFor(AbsentMissing).Length(&vgo.IsLengthOpts{Min: 1}).WithMessage("age is empty"),
+			errs:  []ValidationChainError{{Location: "body", Field: "age", Value: "", Message: "age is empty"}},
+			md:    MatchedData{"body": MatchedDataFieldValues{"age": ""}},
+		},
+		{
+			name:  "OptionalFor(AbsentFalsy) skips zero.",
+			body:  `{"discount": 0}`,
+			chain: NewBodyChain("discount", nil).OptionalFor(AbsentFalsy).CustomValidator(isPositive),
+			errs:  []ValidationChainError{},
+			md:    MatchedData{"body": MatchedDataFieldValues{"discount": "0"}},
+		},
+		{
+			name:  "Optional validates zero.",
+			body:  `{"discount": 0}`,
+			chain: NewBodyChain("discount", nil).Optional().CustomValidator(isPositive).WithMessage("discount must be positive"),
+			errs:  []ValidationChainError{{Location: "body", Field: "discount", Value: "0", Message: "discount must be positive"}},
+			md:    MatchedData{"body": MatchedDataFieldValues{"discount": "0"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var (
+				errs []ValidationChainError
+				md   MatchedData
+			)
+
+			router.POST("/test", test.chain.Validate(), func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+				md, _ = GetMatchedData(ctx)
+			})
+
+			url := test.url
+			if url == "" {
+				url = "/test"
+			}
+
+			req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			for key, value := range test.headers {
+				req.Header.Set(key, value)
+			}
+			router.ServeHTTP(w, req)
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+
+			if !cmp.Equal(md, test.md, cmpopts.EquateEmpty()) {
+				t.Errorf("got matched data %+v, want %+v", md, test.md)
+			}
+		})
+	}
+}
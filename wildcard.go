@@ -0,0 +1,237 @@
+package ginvalidator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// wildcardSegment matches every direct child of an array or object.
+	wildcardSegment string = "*"
+
+	// globstarSegment matches the current node and all of its descendants (recursive descent).
+	globstarSegment string = "**"
+)
+
+// fieldInstance is a single concrete field resolved from a chain's field, together with its extracted value.
+type fieldInstance struct {
+	field      string    // the concrete field (e.g. "items[3].sku")
+	value      string    // the extracted value of the field
+	values     []string  // every extracted value of a multi-value field, in the order they were sent
+	kind       valueKind // how the value was sent; missingValue for a field that is not in the request
+	multiValue bool      // whether the field can be sent several times (query, header, form and file fields)
+	err        error     // the error returned while extracting the value, if any
+
+	files []*uploadedFile // the files of a file field, one per value
+	file  *uploadedFile   // the file of a single value of a file field
+}
+
+// splitFieldPath splits a gjson style path on its unescaped dots.
+// Escape sequences are preserved so each segment remains a valid gjson path component.
+func splitFieldPath(field string) []string {
+	var segments []string
+	var current strings.Builder
+
+	for i := 0; i < len(field); i++ {
+		c := field[i]
+
+		if c == '\\' && i+1 < len(field) {
+			current.WriteByte(c)
+			current.WriteByte(field[i+1])
+			i++
+			continue
+		}
+
+		if c == '.' {
+			segments = append(segments, current.String())
+			current.Reset()
+			continue
+		}
+
+		current.WriteByte(c)
+	}
+
+	return append(segments, current.String())
+}
+
+// hasWildcardSegment reports whether the field contains a "*" or "**" path segment.
+func hasWildcardSegment(field string) bool {
+	for _, segment := range splitFieldPath(field) {
+		if segment == wildcardSegment || segment == globstarSegment {
+			return true
+		}
+	}
+
+	return false
+}
+
+// wildcardSteps groups the segments of a field into steps.
+// Every wildcard becomes a step of its own, while consecutive literal segments
+// are joined back together so they are resolved as a single gjson path.
+func wildcardSteps(field string) []string {
+	var steps []string
+	var literals []string
+
+	for _, segment := range splitFieldPath(field) {
+		if segment == wildcardSegment || segment == globstarSegment {
+			if len(literals) > 0 {
+				steps = append(steps, strings.Join(literals, "."))
+				literals = nil
+			}
+			steps = append(steps, segment)
+			continue
+		}
+
+		literals = append(literals, segment)
+	}
+
+	if len(literals) > 0 {
+		steps = append(steps, strings.Join(literals, "."))
+	}
+
+	return steps
+}
+
+// joinConcretePath appends a path component to a concrete field path.
+// Array indexes are rendered in bracket notation (e.g. "items[3]") and object keys in dot notation.
+func joinConcretePath(prefix, component string) string {
+	if strings.HasPrefix(component, "[") || prefix == "" {
+		return prefix + component
+	}
+
+	return prefix + "." + component
+}
+
+// getImplicitArrayPath resolves a gjson path in which every value that is not an array is treated
+// as a one-element array: the index 0 addresses the value itself and any other index matches nothing.
+// This lets "item.0" address a repeated XML element whether it occurred once or several times.
+func getImplicitArrayPath(result gjson.Result, path string) gjson.Result {
+	for _, segment := range splitFieldPath(path) {
+		if !result.IsArray() && isArrayIndex(segment) {
+			if segment != "0" {
+				return gjson.Result{}
+			}
+			continue
+		}
+
+		result = result.Get(segment)
+	}
+
+	return result
+}
+
+// isArrayIndex reports whether a path segment is a non-negative integer.
+func isArrayIndex(segment string) bool {
+	if segment == "" {
+		return false
+	}
+
+	for _, c := range segment {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// escapeFieldPathKey escapes the characters of an object key that have a meaning in field paths ("\\", ".", "*" and "?").
+func escapeFieldPathKey(key string) string {
+	var escaped strings.Builder
+
+	for i := 0; i < len(key); i++ {
+		switch key[i] {
+		case '\\', '.', '*', '?':
+			escaped.WriteByte('\\')
+		}
+		escaped.WriteByte(key[i])
+	}
+
+	return escaped.String()
+}
+
+// forEachChild calls fn for every direct child of an array or object with the path component addressing it.
+func forEachChild(result gjson.Result, fn func(component string, child gjson.Result)) {
+	if result.IsArray() {
+		for i, child := range result.Array() {
+			fn(fmt.Sprintf("[%d]", i), child)
+		}
+		return
+	}
+
+	if result.IsObject() {
+		result.ForEach(func(key, child gjson.Result) bool {
+			fn(escapeFieldPathKey(key.String()), child)
+			return true
+		})
+	}
+}
+
+// expandWildcardField resolves a field containing "*" or "**" segments against a parsed JSON document
+// and returns one fieldInstance per concrete path, in document order.
+//
+// A "*" segment fans out across every element of an array or every property of an object,
+// while a "**" segment additionally descends recursively through all nested values.
+// When the trailing literal part of a "*" path is missing from a matched element, the instance is
+// still returned as a missing field with an empty value, so that validators can report it.
+// Paths reached through "**" only yield values that are actually present.
+// A wildcard applied to a missing or scalar value matches nothing.
+//
+// With implicitArrays, every value that is not an array is treated as a one-element array,
+// so "*" matches the value itself (as index 0) and literal indexes follow [getImplicitArrayPath].
+func expandWildcardField(document gjson.Result, field string, implicitArrays bool) []fieldInstance {
+	steps := wildcardSteps(field)
+	instances := make([]fieldInstance, 0)
+	seen := make(map[string]bool)
+
+	var walk func(result gjson.Result, step int, prefix string, recursive bool)
+
+	walk = func(result gjson.Result, step int, prefix string, recursive bool) {
+		if step == len(steps) {
+			if prefix == "" || seen[prefix] {
+				return
+			}
+			seen[prefix] = true
+			instances = append(instances, fieldInstance{field: prefix, value: result.String(), kind: jsonValueKind(result)})
+			return
+		}
+
+		switch steps[step] {
+		case wildcardSegment:
+			if implicitArrays && !result.IsArray() {
+				if result.Exists() {
+					walk(result, step+1, joinConcretePath(prefix, "[0]"), recursive)
+				}
+				return
+			}
+
+			forEachChild(result, func(component string, child gjson.Result) {
+				walk(child, step+1, joinConcretePath(prefix, component), recursive)
+			})
+		case globstarSegment:
+			walk(result, step+1, prefix, true)
+			forEachChild(result, func(component string, child gjson.Result) {
+				walk(child, step, joinConcretePath(prefix, component), true)
+			})
+		default:
+			child := result.Get(steps[step])
+			if implicitArrays {
+				child = getImplicitArrayPath(result, steps[step])
+			}
+
+			isLeaf := step == len(steps)-1
+
+			if !child.Exists() && (!isLeaf || recursive) {
+				return
+			}
+
+			walk(child, step+1, joinConcretePath(prefix, steps[step]), recursive)
+		}
+	}
+
+	walk(document, 0, "", false)
+
+	return instances
+}
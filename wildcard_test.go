@@ -0,0 +1,131 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tidwall/gjson"
+)
+
+func TestExpandWildcardField(t *testing.T) {
+	json := `{
+		"items": [
+			{"sku": "A1", "tags": ["x", "y"]},
+			{"name": "no sku"},
+			{"sku": "C3", "meta": {"sku": "nested"}}
+		],
+		"prices": {"usd": 10, "eur": 9},
+		"fav.movie": {"title": "Deer Hunter"}
+	}`
+
+	tests := []struct {
+		name  string
+		field string
+		want  []fieldInstance
+	}{
+		{
+			name:  "Array wildcard with trailing path.",
+			field: "items.*.sku",
+			want: []fieldInstance{
+				{field: "items[0].sku", value: "A1"},
+				{field: "items[1].sku", value: "", kind: missingValue},
+				{field: "items[2].sku", value: "C3"},
+			},
+		},
+		{
+			name:  "Object wildcard.",
+			field: "prices.*",
+			want: []fieldInstance{
+				{field: "prices.usd", value: "10", kind: numberValue},
+				{field: "prices.eur", value: "9", kind: numberValue},
+			},
+		},
+		{
+			name:  "Nested wildcards.",
+			field: "items.*.tags.*",
+			want: []fieldInstance{
+				{field: "items[0].tags[0]", value: "x"},
+				{field: "items[0].tags[1]", value: "y"},
+			},
+		},
+		{
+			name:  "Recursive descent only yields present values.",
+			field: "items.**.sku",
+			want: []fieldInstance{
+				{field: "items[0].sku", value: "A1"},
+				{field: "items[2].sku", value: "C3"},
+				{field: "items[2].meta.sku", value: "nested"},
+			},
+		},
+		{
+			name:  "Escaped dots stay in a single segment.",
+			field: `fav\.movie.*`,
+			want: []fieldInstance{
+				{field: `fav\.movie.title`, value: "Deer Hunter"},
+			},
+		},
+		{
+			name:  "Wildcard over a missing value matches nothing.",
+			field: "missing.*.sku",
+			want:  []fieldInstance{},
+		},
+		{
+			name:  "Wildcard over a scalar matches nothing.",
+			field: "items.0.sku.*",
+			want:  []fieldInstance{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := expandWildcardField(gjson.Parse(json), test.field, false)
+
+			if !cmp.Equal(got, test.want, cmp.AllowUnexported(fieldInstance{})) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWildcardBodyValidationChain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body := `{"items": [{"sku": "A1"}, {"sku": "b!"}, {}]}`
+
+	w := httptest.NewRecorder()
+	router := gin.New()
+
+	var (
+		errs []ValidationChainError
+		md   MatchedData
+	)
+
+	router.POST("/test", NewBodyChain("items.*.sku", nil).Alphanumeric(nil).Validate(), func(ctx *gin.Context) {
+		errs, _ = ValidationResult(ctx)
+		md, _ = GetMatchedData(ctx)
+	})
+
+	req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	wantErrs := []ValidationChainError{
+		{Location: "body", Message: DefaultErrMsg, Field: "items[1].sku", Value: "b!"},
+		{Location: "body", Message: DefaultErrMsg, Field: "items[2].sku", Value: ""},
+	}
+
+	if !cmp.Equal(errs, wantErrs, cmpopts.IgnoreUnexported(ValidationChainError{})) {
+		t.Errorf("got errors %+v, want %+v", errs, wantErrs)
+	}
+
+	wantMD := MatchedData{"body": MatchedDataFieldValues{"items[0].sku": "A1", "items[1].sku": "b!"}}
+
+	if !cmp.Equal(md, wantMD) {
+		t.Errorf("got matched data %+v, want %+v", md, wantMD)
+	}
+}
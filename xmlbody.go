@@ -0,0 +1,197 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// xmlTextKey is the key under which the text content of an element with attributes or child elements is stored.
+const xmlTextKey string = "#text"
+
+// xmlAttrPrefix prefixes the keys of attributes, so they never collide with child elements.
+const xmlAttrPrefix string = "@"
+
+var (
+	// errXMLNoRootElement occurs when an XML body does not contain any element.
+	errXMLNoRootElement = errors.New("xml: no root element")
+
+	// errXMLMultipleRootElements occurs when an XML body contains more than one root element.
+	errXMLMultipleRootElements = errors.New("xml: multiple root elements")
+
+	// errXMLUnbalancedElements occurs when the start and end elements of an XML body do not match.
+	errXMLUnbalancedElements = errors.New("xml: unbalanced start and end elements")
+)
+
+// xmlElement is an element of a parsed XML document.
+type xmlElement struct {
+	name     string
+	attrs    []xml.Attr
+	children []*xmlElement
+	text     strings.Builder
+}
+
+// xmlQualifiedName returns the name of an element or attribute as written in the document, e.g. "soap:Body".
+func xmlQualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+
+	return name.Space + ":" + name.Local
+}
+
+// isXMLNamespaceDecl reports whether an attribute declares a namespace (xmlns or xmlns:prefix).
+func isXMLNamespaceDecl(attr xml.Attr) bool {
+	return attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns")
+}
+
+// decodeXMLBody converts an XML body into a JSON document so its elements and attributes
+// can be addressed with the same path syntax as a JSON body, e.g. "order.items.item.0.@sku".
+//
+// The conversion follows these rules:
+//   - The root element becomes the single top-level key of the document.
+//   - An element without attributes or child elements becomes its text content.
+//   - Any other element becomes an object: attributes are keyed by their name prefixed with "@",
+//     child elements by their name and non-blank text content by "#text".
+//   - Child elements that occur more than once under the same parent become an array, in document order.
+//   - Names are matched as written, including their namespace prefix (e.g. "soap:Body").
+//     Namespace URIs are not resolved and xmlns declarations are dropped.
+func decodeXMLBody(raw []byte, params map[string]string) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(raw))
+
+	var (
+		root  *xmlElement
+		stack []*xmlElement
+	)
+
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &xmlElement{name: xmlQualifiedName(t.Name)}
+			for _, attr := range t.Attr {
+				if !isXMLNamespaceDecl(attr) {
+					element.attrs = append(element.attrs, attr)
+				}
+			}
+
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, errXMLMultipleRootElements
+				}
+				root = element
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, element)
+			}
+
+			stack = append(stack, element)
+		case xml.EndElement:
+			if len(stack) == 0 || stack[len(stack)-1].name != xmlQualifiedName(t.Name) {
+				return nil, errXMLUnbalancedElements
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, errXMLNoRootElement
+	}
+
+	if len(stack) > 0 {
+		return nil, errXMLUnbalancedElements
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	writeJSONString(&buf, root.name)
+	buf.WriteByte(':')
+	writeXMLElementJSON(&buf, root)
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// writeXMLElementJSON writes the JSON value of an element, preserving the document order of its children.
+func writeXMLElementJSON(buf *bytes.Buffer, element *xmlElement) {
+	if len(element.attrs) == 0 && len(element.children) == 0 {
+		writeJSONString(buf, element.text.String())
+		return
+	}
+
+	buf.WriteByte('{')
+	first := true
+	writeKey := func(key string) {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		writeJSONString(buf, key)
+		buf.WriteByte(':')
+	}
+
+	for _, attr := range element.attrs {
+		writeKey(xmlAttrPrefix + xmlQualifiedName(attr.Name))
+		writeJSONString(buf, attr.Value)
+	}
+
+	var names []string
+	grouped := make(map[string][]*xmlElement)
+
+	for _, child := range element.children {
+		if _, ok := grouped[child.name]; !ok {
+			names = append(names, child.name)
+		}
+		grouped[child.name] = append(grouped[child.name], child)
+	}
+
+	for _, name := range names {
+		writeKey(name)
+
+		occurrences := grouped[name]
+		if len(occurrences) == 1 {
+			writeXMLElementJSON(buf, occurrences[0])
+			continue
+		}
+
+		buf.WriteByte('[')
+		for i, occurrence := range occurrences {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeXMLElementJSON(buf, occurrence)
+		}
+		buf.WriteByte(']')
+	}
+
+	if text := strings.TrimSpace(element.text.String()); text != "" {
+		writeKey(xmlTextKey)
+		writeJSONString(buf, text)
+	}
+
+	buf.WriteByte('}')
+}
+
+// writeJSONString writes s as a JSON string literal, without escaping HTML characters.
+func writeJSONString(buf *bytes.Buffer, s string) {
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(s)
+
+	// Encode terminates the literal with a newline.
+	buf.Truncate(buf.Len() - 1)
+}
@@ -0,0 +1,161 @@
+package ginvalidator
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestDecodeXMLBody(t *testing.T) {
+	tests := []struct {
+		name string
+		xml  string
+
+		want string
+		err  error
+	}{
+		{
+			name: "Leaf elements become text.",
+			xml:  `<user><name>John</name><age>30</age></user>`,
+			want: `{"user":{"name":"John","age":"30"}}`,
+		},
+		{
+			name: "Attributes are prefixed and text is kept.",
+			xml:  `<price currency="USD"> 10 </price>`,
+			want: `{"price":{"@currency":"USD","#text":"10"}}`,
+		},
+		{
+			name: "Repeated elements become arrays in document order.",
+			xml:  `<items><item sku="A"/><note>n</note><item sku="B"/></items>`,
+			want: `{"items":{"item":[{"@sku":"A"},{"@sku":"B"}],"note":"n"}}`,
+		},
+		{
+			name: "Namespace prefixes are kept and declarations dropped.",
+			xml:  `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns="urn:x"><soap:Body id="1">ok</soap:Body></soap:Envelope>`,
+			want: `{"soap:Envelope":{"soap:Body":{"@id":"1","#text":"ok"}}}`,
+		},
+		{
+			name: "CDATA is text.",
+			xml:  `<note><![CDATA[<b>hi</b>]]></note>`,
+			want: `{"note":"<b>hi</b>"}`,
+		},
+		{name: "Multiple root elements.", xml: `<a/><b/>`, err: errXMLMultipleRootElements},
+		{name: "Unbalanced elements.", xml: `<a><b></a>`, err: errXMLUnbalancedElements},
+		{name: "No root element.", xml: `<?xml version="1.0"?>`, err: errXMLNoRootElement},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := decodeXMLBody([]byte(test.xml), nil)
+
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Errorf("got error %v, want %v", err, test.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(got) != test.want {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestXMLBodyValidationChain(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	oneItem := `<order id="7"><items><item sku="A1"><qty>2</qty></item></items></order>`
+	twoItems := `<order id="7"><items><item sku="A1"><qty>2</qty></item><item sku="b!"><qty>x</qty></item></items></order>`
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		chains      []gin.HandlerFunc
+
+		errs []ValidationChainError
+		md   MatchedData
+	}{
+		{
+			name:        "Index 0 addresses a single element.",
+			contentType: "application/xml",
+			body:        oneItem,
+			chains:      []gin.HandlerFunc{NewBodyChain("order.items.item.0.@sku", nil).Alphanumeric(nil).Validate()},
+			errs:        []ValidationChainError{},
+			md:          MatchedData{"body": MatchedDataFieldValues{"order.items.item.0.@sku": "A1"}},
+		},
+		{
+			name:        "Index addresses a repeated element.",
+			contentType: "text/xml; charset=utf-8",
+			body:        twoItems,
+			chains:      []gin.HandlerFunc{NewBodyChain("order.items.item.1.qty", nil).Numeric(nil).Validate()},
+			errs:        []ValidationChainError{{Location: "body", Field: "order.items.item.1.qty", Value: "x"}},
+			md:          MatchedData{"body": MatchedDataFieldValues{"order.items.item.1.qty": "x"}},
+		},
+		{
+			name:        "Wildcard over a single element.",
+			contentType: "application/xml",
+			body:        oneItem,
+			chains:      []gin.HandlerFunc{NewBodyChain("order.items.item.*.@sku", nil).Alphanumeric(nil).Validate()},
+			errs:        []ValidationChainError{},
+			md:          MatchedData{"body": MatchedDataFieldValues{"order.items.item[0].@sku": "A1"}},
+		},
+		{
+			name:        "Wildcard over repeated elements.",
+			contentType: "application/atom+xml",
+			body:        twoItems,
+			chains:      []gin.HandlerFunc{NewBodyChain("order.items.item.*.@sku", nil).Alphanumeric(nil).Validate()},
+			errs:        []ValidationChainError{{Location: "body", Field: "order.items.item[1].@sku", Value: "b!"}},
+			md:          MatchedData{"body": MatchedDataFieldValues{"order.items.item[0].@sku": "A1", "order.items.item[1].@sku": "b!"}},
+		},
+		{
+			name:        "Malformed XML.",
+			contentType: "application/xml",
+			body:        `<order>`,
+			chains:      []gin.HandlerFunc{NewBodyChain("order.@id", nil).Validate()},
+			errs:        []ValidationChainError{{Location: "body", Field: "order.@id", Code: InvalidBodyCode}},
+			md:          nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router := gin.New()
+
+			var (
+				errs []ValidationChainError
+				md   MatchedData
+			)
+
+			handlers := append(test.chains, func(ctx *gin.Context) {
+				errs, _ = ValidationResult(ctx)
+				md, _ = GetMatchedData(ctx)
+			})
+			router.POST("/test", handlers...)
+
+			req, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewBufferString(test.body))
+			req.Header.Set("Content-Type", test.contentType)
+			router.ServeHTTP(w, req)
+
+			if !cmp.Equal(errs, test.errs, cmpopts.IgnoreUnexported(ValidationChainError{}), cmpopts.IgnoreFields(ValidationChainError{}, "Message"), cmpopts.EquateEmpty()) {
+				t.Errorf("got errors %+v, want %+v", errs, test.errs)
+			}
+
+			if !cmp.Equal(md, test.md, cmpopts.EquateEmpty()) {
+				t.Errorf("got matched data %+v, want %+v", md, test.md)
+			}
+		})
+	}
+}